@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package actionwait contains helpers for actions that wait for an operation to complete.
+package actionwait
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// SendProgress sends a progress message to Terraform, if the response supports progress events.
+func SendProgress(response *action.InvokeResponse, message string) {
+	if response == nil || response.SendProgress == nil {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{Message: message})
+}
+
+// StateRefreshFunc returns a StateRefreshFunc that calls f and sends a progress message
+// each time the refreshed state changes.
+// It is intended to wrap the Refresh function of waiters used by actions.
+func StateRefreshFunc(response *action.InvokeResponse, description string, f retry.StateRefreshFunc) retry.StateRefreshFunc {
+	var lastState string
+
+	return func() (any, string, error) {
+		output, state, err := f()
+
+		if err == nil && state != "" && state != lastState {
+			SendProgress(response, fmt.Sprintf("%s: %s", description, state))
+			lastState = state
+		}
+
+		return output, state, err
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package actionwait
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+func TestStateRefreshFunc(t *testing.T) {
+	t.Parallel()
+
+	var messages []string
+	response := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	states := []string{"pending", "pending", "", "running", "stopped"}
+	var i int
+	f := StateRefreshFunc(&response, "EC2 Instance (i-12345678)", func() (any, string, error) {
+		state := states[i]
+		i++
+		return struct{}{}, state, nil
+	})
+
+	for range states {
+		if _, _, err := f(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expected := []string{
+		"EC2 Instance (i-12345678): pending",
+		"EC2 Instance (i-12345678): running",
+		"EC2 Instance (i-12345678): stopped",
+	}
+	if diff := cmp.Diff(messages, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSendProgressNoFunc(t *testing.T) {
+	t.Parallel()
+
+	// Must not panic.
+	SendProgress(nil, "message")
+	SendProgress(&action.InvokeResponse{}, "message")
+}
@@ -41,10 +41,10 @@ type AWSClient struct {
 	region                    string
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3.Client // Keyed by Region.
+	s3UsePathStyle            bool                  // From provider configuration.
+	s3USEast1RegionalEndpoint string                // From provider configuration.
+	stsRegion                 string                // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -102,11 +102,36 @@ func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
 }
 
-// Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// Region returns the ID of the AWS Region in effect.
+// This is any per-resource Region override in Context, otherwise the configured AWS Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion(); v != "" {
+			return v
+		}
+	}
+
+	return c.region
+}
+
+// DefaultRegion returns the ID of the configured AWS Region, ignoring any per-resource Region override.
+func (c *AWSClient) DefaultRegion(context.Context) string {
 	return c.region
 }
 
+// ValidateInContextRegionInPartition verifies that any per-resource Region override in Context is in the configured AWS partition.
+func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
+	if inContext, ok := FromContext(ctx); ok {
+		if region := inContext.OverrideRegion(); region != "" {
+			if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() != c.Partition(ctx) {
+				return fmt.Errorf("region (%s) is in partition (%s), not the configured partition (%s)", region, p.ID(), c.Partition(ctx))
+			}
+		}
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -161,18 +186,25 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	region := c.Region(ctx)
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
+		}
+
+		if c.s3ExpressClients == nil {
+			c.s3ExpressClients = make(map[string]*s3.Client)
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -321,6 +353,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"aws_sdkv2_config": c.awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
+		"region":           c.Region(ctx),
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -338,19 +371,29 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// clientCacheKey returns the key used to cache the default API client for the specified service in the Region in effect.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "@" + region
+	}
+
+	return servicePackageName
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -385,7 +428,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -61,10 +62,11 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 
 	ctx := context.TODO()
 	testCases := []struct {
-		Name      string
-		AWSClient *AWSClient
-		Prefix    string
-		Expected  string
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Prefix         string
+		Expected       string
 	}{
 		{
 			Name: "AWS Commercial",
@@ -75,6 +77,16 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Prefix:   "test",
 			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
 		},
+		{
+			Name: "AWS Commercial Region override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Prefix:         "test",
+			Expected:       "test.eu-west-1.amazonaws.com", //lintignore:AWSAT003
+		},
 		{
 			Name: "AWS China",
 			AWSClient: &AWSClient{
@@ -90,6 +102,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
+			ctx := NewResourceContext(ctx, "test", "Test", testCase.OverrideRegion)
 			got := testCase.AWSClient.RegionalHostname(ctx, testCase.Prefix)
 
 			if got != testCase.Expected {
@@ -99,6 +112,55 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegionalARN(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "configured Region",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "Region override",
+			AWSClient: &AWSClient{
+				accountID: "123456789012",
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-central-1",                               //lintignore:AWSAT003
+			Expected:       "arn:aws:sqs:eu-central-1:123456789012:test", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(ctx, names.SQS, "Queue", testCase.OverrideRegion)
+			got := testCase.AWSClient.RegionalARN(ctx, "sqs", "test")
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got, want := testCase.AWSClient.DefaultRegion(ctx), testCase.AWSClient.region; got != want {
+				t.Errorf("DefaultRegion got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
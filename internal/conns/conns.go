@@ -5,7 +5,9 @@ package conns
 
 import (
 	"context"
+	"strings"
 
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
@@ -19,6 +21,13 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions perform imperative operations that do not map to the desired state of any resource.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
 // ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
 // Ephemeral resources are resources that are not part of the Terraform state, but are used to create other resources.
 type ServicePackageWithEphemeralResources interface {
@@ -26,6 +35,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// List resources discover existing AWS objects that can be brought under management by the service package's resources.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -40,32 +56,41 @@ type InContext struct {
 	IsEphemeralResource bool   // Ephemeral resource?
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
+	overrideRegion      string // Any currently in effect per-resource Region override.
+}
+
+// OverrideRegion returns any currently in effect per-resource Region override.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		overrideRegion:     overrideRegion,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		IsEphemeralResource: true,
 		ResourceName:        resourceName,
 		ServicePackageName:  servicePackageName,
+		overrideRegion:      overrideRegion,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, overrideRegion string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		overrideRegion:     overrideRegion,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -75,3 +100,19 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
 }
+
+// ParseImportIDWithRegion parses an import ID of the form `id@region`.
+// The suffix is only treated as a Region if it looks like one, so that IDs such as email addresses are left intact.
+func ParseImportIDWithRegion(importID string) (string, string, bool) {
+	i := strings.LastIndex(importID, "@")
+	if i <= 0 || i == len(importID)-1 {
+		return importID, "", false
+	}
+
+	id, region := importID[:i], importID[i+1:]
+	if _, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok {
+		return importID, "", false
+	}
+
+	return id, region, true
+}
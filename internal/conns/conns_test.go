@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestParseImportIDWithRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		importID       string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		{
+			name:       "empty",
+			importID:   "",
+			expectedID: "",
+		},
+		{
+			name:       "no Region",
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			name:           "Region",
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:       "email address",
+			importID:   "someone@example.com",
+			expectedID: "someone@example.com",
+		},
+		{
+			name:       "trailing separator",
+			importID:   "vpc-12345678@",
+			expectedID: "vpc-12345678@",
+		},
+		{
+			name:       "leading separator",
+			importID:   "@eu-west-1", //lintignore:AWSAT003
+			expectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:           "multiple separators",
+			importID:       "someone@example.com@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "someone@example.com",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := ParseImportIDWithRegion(testCase.importID)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+		})
+	}
+}
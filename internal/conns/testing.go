@@ -16,3 +16,13 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetAccountID is only intended for use in tests
+func SetAccountID(client *AWSClient, accountID string) {
+	client.accountID = accountID
+}
+
+// SetRegion is only intended for use in tests
+func SetRegion(client *AWSClient, region string) {
+	client.region = region
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ListResourceWithConfigure is a structure to be embedded within a list resource.
+type ListResourceWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined list resource type.
+func (l *ListResourceWithConfigure) Configure(_ context.Context, providerData any) {
+	if v, ok := providerData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
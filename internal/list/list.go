@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package list defines the interface implemented by list resources.
+// A list resource discovers existing AWS objects of a single managed resource type
+// so that they can be brought under management using Terraform import blocks.
+package list
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ListResource is the interface implemented by list resources.
+type ListResource interface {
+	// Configure is called with the provider instance data before List is called.
+	Configure(ctx context.Context, providerData any)
+	// List calls yield for each AWS object matching the request's filters.
+	// Listing stops, without error, if yield returns false.
+	List(ctx context.Context, request ListRequest, yield func(ListResult) bool) error
+}
+
+// ListRequest represents a request to list AWS objects.
+type ListRequest struct {
+	// Filters restrict the AWS objects listed.
+	// The supported filter names are specific to each list resource.
+	Filters map[string]string
+}
+
+// ValidateFilters returns an error if the request contains any filter whose name is not in supported.
+func (r ListRequest) ValidateFilters(supported ...string) error {
+	for k := range r.Filters {
+		if !slices.Contains(supported, k) {
+			return fmt.Errorf("unsupported filter %q, supported filters: %s", k, strings.Join(supported, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ListResult represents a single AWS object found by a list resource.
+type ListResult struct {
+	DisplayName string            // Human-readable name of the AWS object, e.g. the value of its Name tag.
+	Identity    map[string]string // The values of the AWS object's resource identity attributes.
+	ImportID    string            // The ID used to import the AWS object.
+}
+
+// ImportBlock returns a Terraform import block that brings the specified AWS object under management
+// as a resource of the specified type.
+func ImportBlock(typeName string, result ListResult) string {
+	var sb strings.Builder
+
+	sb.WriteString("import {\n")
+	fmt.Fprintf(&sb, "  to = %s.%s\n", typeName, resourceName(result))
+	fmt.Fprintf(&sb, "  id = %s\n", strconv.Quote(result.ImportID))
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// resourceName returns a valid Terraform resource name for the specified AWS object.
+func resourceName(result ListResult) string {
+	name := result.DisplayName
+	if name == "" {
+		name = result.ImportID
+	}
+
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '_'
+		}
+	}, name)
+
+	// Names must start with a letter or underscore.
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] == '_') {
+		name = "_" + name
+	}
+
+	return name
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"testing"
+)
+
+func TestImportBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		typeName string
+		result   ListResult
+		expected string
+	}{
+		{
+			name:     "display name",
+			typeName: "aws_vpc",
+			result: ListResult{
+				DisplayName: "Main VPC",
+				ImportID:    "vpc-12345678",
+			},
+			expected: `import {
+  to = aws_vpc.main_vpc
+  id = "vpc-12345678"
+}
+`,
+		},
+		{
+			name:     "no display name",
+			typeName: "aws_vpc",
+			result: ListResult{
+				ImportID: "vpc-12345678",
+			},
+			expected: `import {
+  to = aws_vpc.vpc-12345678
+  id = "vpc-12345678"
+}
+`,
+		},
+		{
+			name:     "leading digit",
+			typeName: "aws_s3_bucket",
+			result: ListResult{
+				DisplayName: "123.example.com",
+				ImportID:    "123.example.com",
+			},
+			expected: `import {
+  to = aws_s3_bucket._123_example_com
+  id = "123.example.com"
+}
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ImportBlock(testCase.typeName, testCase.result), testCase.expected; got != want {
+				t.Errorf("ImportBlock = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestListRequestValidateFilters(t *testing.T) {
+	t.Parallel()
+
+	request := ListRequest{
+		Filters: map[string]string{
+			"name_prefix": "example",
+		},
+	}
+
+	if err := request.ValidateFilters("name_prefix", "path_prefix"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := request.ValidateFilters("path_prefix"); err == nil {
+		t.Error("expected error, got none")
+	}
+}
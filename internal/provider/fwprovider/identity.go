@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateIdentitySchema verifies that the specified schema defines all of the identity's natural key attributes.
+func validateIdentitySchema(identity *types.ServicePackageResourceIdentity, attributes map[string]schema.Attribute) error {
+	for _, v := range identity.Attributes {
+		if _, ok := attributes[v.Name]; !ok {
+			return fmt.Errorf("identity attribute `%s` not defined in schema", v.Name)
+		}
+	}
+
+	return nil
+}
+
+// identitySchema returns the Plugin Framework identity schema for the specified resource identity.
+func identitySchema(identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+		},
+	}
+	if !identity.IsGlobalResource {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+	for _, v := range identity.Attributes {
+		attributes[v.Name] = identityschema.StringAttribute{
+			RequiredForImport: v.Required,
+			OptionalForImport: !v.Required,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// identityResourceInterceptor sets the resource's identity after CRU.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(setIdentity(ctx, response.Identity, r.identity, response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(setIdentity(ctx, response.Identity, r.identity, response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(setIdentity(ctx, response.Identity, r.identity, response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// setIdentity sets the values of all of the identity's attributes from the specified state.
+func setIdentity(ctx context.Context, target *tfsdk.ResourceIdentity, identity *types.ServicePackageResourceIdentity, state tfsdk.State, c *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if target == nil {
+		return diags
+	}
+
+	diags.Append(target.SetAttribute(ctx, path.Root(names.AttrAccountID), c.AccountID(ctx))...)
+	if !identity.IsGlobalResource {
+		diags.Append(target.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+	}
+	for _, v := range identity.Attributes {
+		var value fwtypes.String
+		diags.Append(state.GetAttribute(ctx, path.Root(v.Name), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(target.SetAttribute(ctx, path.Root(v.Name), value)...)
+	}
+
+	return diags
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that has an identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+	identity *types.ServicePackageResourceIdentity
+}
+
+func newWrappedResourceWithIdentity(inner *wrappedResource, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	return &wrappedResourceWithIdentity{
+		wrappedResource: inner,
+		identity:        identity,
+	}
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identitySchema(w.identity)
+}
+
+// ImportState accepts an `identity` in place of an import ID.
+// The account ID and Region in the identity must match those of the provider or, for resources that
+// support per-resource Region override, the Region is imported as for an `id@region` import ID.
+// For resources identified by a single attribute, the import ID is set from the identity.
+func (w *wrappedResourceWithIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID != "" || request.Identity == nil || request.Identity.Raw.IsNull() {
+		w.wrappedResource.ImportState(ctx, request, response)
+		return
+	}
+
+	var accountID fwtypes.String
+	response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := accountID.ValueString(); v != "" && v != w.meta.AccountID(ctx) {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrAccountID), "Invalid Identity", fmt.Sprintf("identity %s (%s) does not match provider account ID (%s)", names.AttrAccountID, v, w.meta.AccountID(ctx)))
+		return
+	}
+
+	var region string
+	if !w.identity.IsGlobalResource {
+		var v fwtypes.String
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrRegion), &v)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		region = v.ValueString()
+		if region != "" && !w.isRegionOverrideEnabled() && region != w.meta.Region(ctx) {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Identity", fmt.Sprintf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, region, w.meta.Region(ctx)))
+			return
+		}
+	}
+
+	if len(w.identity.Attributes) != 1 {
+		w.wrappedResource.ImportState(ctx, request, response)
+		return
+	}
+
+	name := w.identity.Attributes[0].Name
+	var v fwtypes.String
+	response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(name), &v)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.ID = v.ValueString()
+	if region != "" && w.isRegionOverrideEnabled() {
+		request.ID = fmt.Sprintf("%s@%s", request.ID, region)
+	}
+
+	w.wrappedResource.ImportState(ctx, request, response)
+}
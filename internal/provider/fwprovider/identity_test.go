@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testResource is a resource whose `name` attribute is its import ID.
+type testResource struct{}
+
+func (r *testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test_resource"
+}
+
+func (r *testResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func (r *testResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+func TestIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity *types.ServicePackageResourceIdentity
+		want     map[string]bool // Attribute name to RequiredForImport.
+	}{
+		"global": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			want: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrName:      true,
+			},
+		},
+		"regional optional attribute": {
+			identity: types.RegionalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrName, true),
+				types.StringIdentityAttribute("qualifier", false),
+			),
+			want: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrRegion:    false,
+				names.AttrName:      true,
+				"qualifier":         false,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			s := identitySchema(testCase.identity)
+
+			if diags := s.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := len(s.Attributes), len(testCase.want); got != want {
+				t.Errorf("len(attributes) = %d, want %d", got, want)
+			}
+			for k, required := range testCase.want {
+				v, ok := s.Attributes[k]
+				if !ok {
+					t.Errorf("attribute %s not found", k)
+					continue
+				}
+				if v.IsRequiredForImport() != required || v.IsOptionalForImport() == required {
+					t.Errorf("attribute %s RequiredForImport = %t, OptionalForImport = %t, want RequiredForImport %t", k, v.IsRequiredForImport(), v.IsOptionalForImport(), required)
+				}
+			}
+		})
+	}
+}
+
+func TestWrappedResourceWithIdentityImportState(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := map[string]struct {
+		region         *types.ServicePackageResourceRegion
+		importID       string
+		identityValues map[string]string
+		wantErr        bool
+		wantName       string
+		wantRegion     string
+	}{
+		"import ID": {
+			importID: "test",
+			wantName: "test",
+		},
+		"identity": {
+			identityValues: map[string]string{
+				names.AttrName: "test",
+			},
+			wantName: "test",
+		},
+		"identity matching account ID and Region": {
+			identityValues: map[string]string{
+				names.AttrAccountID: accountID,
+				names.AttrRegion:    region,
+				names.AttrName:      "test",
+			},
+			wantName: "test",
+		},
+		"identity wrong account ID": {
+			identityValues: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "test",
+			},
+			wantErr: true,
+		},
+		"identity wrong Region": {
+			identityValues: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			wantErr: true,
+		},
+		"identity Region override": {
+			region: &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+			identityValues: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			wantName:   "test",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			meta := &conns.AWSClient{}
+			conns.SetAccountID(meta, accountID)
+			conns.SetRegion(meta, region)
+
+			identity := types.RegionalSingleParameterIdentity(names.AttrName)
+			inner := newWrappedResource(testBootstrapContext, &testResource{}, nil, testCase.region)
+			inner.meta = meta
+			w := newWrappedResourceWithIdentity(inner, identity).(*wrappedResourceWithIdentity)
+
+			var schemaResponse resource.SchemaResponse
+			w.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			resourceSchema := schemaResponse.Schema
+
+			request := resource.ImportStateRequest{
+				ID: testCase.importID,
+			}
+			if testCase.identityValues != nil {
+				s := identitySchema(identity)
+				typ := s.Type().TerraformType(ctx).(tftypes.Object)
+				values := make(map[string]tftypes.Value)
+				for k := range typ.AttributeTypes {
+					if v, ok := testCase.identityValues[k]; ok {
+						values[k] = tftypes.NewValue(tftypes.String, v)
+					} else {
+						values[k] = tftypes.NewValue(tftypes.String, nil)
+					}
+				}
+				request.Identity = &tfsdk.ResourceIdentity{Schema: s, Raw: tftypes.NewValue(typ, values)}
+			}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)},
+			}
+			w.ImportState(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("error = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			var v fwtypes.String
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrName), &v)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+			if got, want := v.ValueString(), testCase.wantName; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrName, got, want)
+			}
+
+			if testCase.region != nil {
+				response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &v)...)
+				if response.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", response.Diagnostics)
+				}
+				if got, want := v.ValueString(), testCase.wantRegion; got != want {
+					t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -22,14 +23,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithActions = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -341,8 +345,8 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, overrideRegion string, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -372,8 +376,20 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The data source has opted in to per-resource Region override.
+				// Ensure that the schema doesn't already define a `region` attribute.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+			}
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, v.Region)
 			})
 		}
 	}
@@ -417,8 +433,8 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, overrideRegion string, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -457,8 +473,43 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema doesn't already define a `region` attribute.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+			}
+
+			if v.Identity != nil {
+				// The resource has declared an identity.
+				// Ensure that the schema defines all of the identity's natural key attributes.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := validateIdentitySchema(v.Identity, schemaResponse.Schema.Attributes); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+				if _, ok := inner.(resource.ResourceWithIdentity); ok {
+					errs = append(errs, fmt.Errorf("identity already defined: %s", typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				w := newWrappedResource(bootstrapContext, inner, interceptors, v.Region)
+				if v.Identity != nil {
+					return newWrappedResourceWithIdentity(w, v.Identity)
+				}
+
+				return w
 			})
 		}
 	}
@@ -472,6 +523,78 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// The action type name is determined by the Action implementing
+// the Metadata method. All actions must have unique names.
+func (p *fwprovider) Actions(ctx context.Context) []func() action.Action {
+	var errs []error
+	var actions []func() action.Action
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithActions); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.Actions(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating action", map[string]interface{}{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				metadataResponse := action.MetadataResponse{}
+				inner.Metadata(ctx, action.MetadataRequest{}, &metadataResponse)
+				typeName := metadataResponse.TypeName
+
+				// Temporary check that type name from annotation equals Metadata response.
+				if typeName != v.TypeName {
+					errs = append(errs, fmt.Errorf("action %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, v.TypeName))
+				}
+
+				// bootstrapContext is run on all wrapped methods.
+				bootstrapContext := func(ctx context.Context, overrideRegion string, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					if meta != nil {
+						ctx = meta.RegisterLogger(ctx)
+						ctx = flex.RegisterLogger(ctx)
+					}
+					return ctx
+				}
+
+				if v.Region != nil && v.Region.IsOverrideEnabled {
+					// The action has opted in to per-resource Region override.
+					// Ensure that the schema doesn't already define a `region` attribute.
+					schemaResponse := action.SchemaResponse{}
+					inner.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+						continue
+					}
+				}
+
+				actions = append(actions, func() action.Action {
+					return newWrappedAction(bootstrapContext, inner, v.Region)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering actions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return actions
+}
+
 // EphemeralResources returns a slice of functions to instantiate each Ephemeral Resource
 // implementation.
 //
@@ -507,8 +630,8 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 				}
 
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				bootstrapContext := func(ctx context.Context, overrideRegion string, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
 					if meta != nil {
 						ctx = meta.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
@@ -517,8 +640,20 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					return ctx
 				}
 
+				if v.Region != nil && v.Region.IsOverrideEnabled {
+					// The ephemeral resource has opted in to per-resource Region override.
+					// Ensure that the schema doesn't already define a `region` attribute.
+					schemaResponse := ephemeral.SchemaResponse{}
+					inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+						continue
+					}
+				}
+
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
-					return newWrappedEphemeralResource(bootstrapContext, inner, nil)
+					return newWrappedEphemeralResource(bootstrapContext, inner, nil, v.Region)
 				})
 			}
 		}
@@ -533,6 +668,72 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each list resource
+// implementation.
+//
+// Each list resource lists the AWS objects of a managed resource type that declares a resource identity.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			// The identities of the service package's managed resources.
+			identities := make(map[string]*itypes.ServicePackageResourceIdentity)
+			for _, v := range data.FrameworkResources(ctx) {
+				identities[v.TypeName] = v.Identity
+			}
+			for _, v := range data.SDKResources(ctx) {
+				identities[v.TypeName] = v.Identity
+			}
+
+			for _, v := range data.ListResources(ctx) {
+				typeName := v.TypeName
+
+				identity := identities[typeName]
+				if identity == nil {
+					errs = append(errs, fmt.Errorf("list resource %s %s: no resource identity", servicePackageName, typeName))
+					continue
+				}
+
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating list resource", map[string]interface{}{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				// bootstrapContext is run on all wrapped methods.
+				bootstrapContext := func(ctx context.Context, overrideRegion string, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					if meta != nil {
+						ctx = meta.RegisterLogger(ctx)
+					}
+					return ctx
+				}
+
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(bootstrapContext, inner, identity, v.Region)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
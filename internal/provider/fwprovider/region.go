@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+// typer is implemented by all Plugin Framework schemas.
+type typer interface {
+	Type() attr.Type
+}
+
+func actionRegionSchemaAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		Optional:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+func dataSourceRegionSchemaAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+func ephemeralResourceRegionSchemaAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+func resourceRegionSchemaAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+// regionValue returns the value of the top-level `region` attribute in the specified raw object value.
+func regionValue(raw tftypes.Value) tftypes.Value {
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		return v
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionString returns the value of the top-level `region` attribute in the specified raw object value
+// as a string. Null and unknown values are returned as "".
+func regionString(raw tftypes.Value) string {
+	var region string
+	if v := regionValue(raw); v.IsKnown() && !v.IsNull() {
+		if err := v.As(&region); err != nil {
+			return ""
+		}
+	}
+
+	return region
+}
+
+// withoutRegion returns a copy of the specified raw object value with the top-level `region` attribute removed.
+// The returned value conforms to the specified (inner) schema.
+func withoutRegion(ctx context.Context, raw tftypes.Value, schema typer) (tftypes.Value, error) {
+	return transformObject(raw, schema.Type().TerraformType(ctx), func(attributes map[string]tftypes.Value) {
+		delete(attributes, names.AttrRegion)
+	})
+}
+
+// withRegion returns a copy of the specified raw object value with the top-level `region` attribute set.
+// The returned value conforms to the specified (outer) schema.
+func withRegion(ctx context.Context, raw tftypes.Value, schema typer, region tftypes.Value) (tftypes.Value, error) {
+	return transformObject(raw, schema.Type().TerraformType(ctx), func(attributes map[string]tftypes.Value) {
+		attributes[names.AttrRegion] = region
+	})
+}
+
+// transformObject returns a copy of the specified raw object value, of the specified type, with its attributes transformed by f.
+// Null and unknown values are returned as null and unknown values of the specified type.
+func transformObject(raw tftypes.Value, typ tftypes.Type, f func(map[string]tftypes.Value)) (tftypes.Value, error) {
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attributes = maps.Clone(attributes)
+	f(attributes)
+
+	if err := tftypes.ValidateValue(typ, attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
@@ -5,50 +5,233 @@ package fwprovider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // contextFunc augments Context.
-type contextFunc func(context.Context, *conns.AWSClient) context.Context
+// The string argument is any per-resource Region override.
+type contextFunc func(context.Context, string, *conns.AWSClient) context.Context
+
+// wrappedAction represents a dispatcher for a Plugin Framework action.
+type wrappedAction struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            action.ActionWithConfigure
+	innerSchema      *actionschema.Schema
+	meta             *conns.AWSClient
+	region           *types.ServicePackageResourceRegion
+}
+
+func newWrappedAction(bootstrapContext contextFunc, inner action.ActionWithConfigure, region *types.ServicePackageResourceRegion) action.ActionWithConfigure {
+	return &wrappedAction{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		region:           region,
+	}
+}
+
+func (w *wrappedAction) Metadata(ctx context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, "", w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, "", w.meta)
+	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled() {
+		attributes := maps.Clone(response.Schema.Attributes)
+		attributes[names.AttrRegion] = actionRegionSchemaAttribute()
+		response.Schema.Attributes = attributes
+	}
+}
+
+func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
+
+	if !w.isRegionOverrideEnabled() {
+		w.inner.Invoke(ctx, request, response)
+		return
+	}
+
+	if w.region.IsValidateOverrideInPartition {
+		if err := w.meta.ValidateInContextRegionInPartition(ctx); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			return
+		}
+	}
+
+	// The inner action is unaware of the `region` attribute.
+	schema := w.schema(ctx)
+	config, err := withoutRegion(ctx, request.Config.Raw, schema)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+
+	w.inner.Invoke(ctx, innerRequest, response)
+}
+
+func (w *wrappedAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, "", w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	if v, ok := w.inner.(action.ActionWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, "", w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	if v, ok := w.inner.(action.ActionWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
+
+		if w.isRegionOverrideEnabled() {
+			schema := w.schema(ctx)
+			config, err := withoutRegion(ctx, request.Config.Raw, schema)
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: config}
+		}
+
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+func (w *wrappedAction) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
+// overrideRegion returns any per-resource Region override value from the specified raw value.
+func (w *wrappedAction) overrideRegion(raw tftypes.Value) string {
+	if !w.isRegionOverrideEnabled() {
+		return ""
+	}
+
+	return regionString(raw)
+}
+
+// schema returns the inner action's schema.
+func (w *wrappedAction) schema(ctx context.Context) actionschema.Schema {
+	if w.innerSchema == nil {
+		var response action.SchemaResponse
+		w.inner.Schema(ctx, action.SchemaRequest{}, &response)
+		w.innerSchema = &response.Schema
+	}
+
+	return *w.innerSchema
+}
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
+	innerSchema      *datasourceschema.Schema
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	region           *types.ServicePackageResourceRegion
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, region *types.ServicePackageResourceRegion) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled() {
+		attributes := maps.Clone(response.Schema.Attributes)
+		attributes[names.AttrRegion] = dataSourceRegionSchemaAttribute()
+		response.Schema.Attributes = attributes
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		if w.region.IsValidateOverrideInPartition {
+			if err := w.meta.ValidateInContextRegionInPartition(ctx); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+				return response.Diagnostics
+			}
+		}
+
+		// The inner data source is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		config, errConfig := withoutRegion(ctx, request.Config.Raw, schema)
+		state, errState := withoutRegion(ctx, response.State.Raw, schema)
+		if err := errors.Join(errConfig, errState); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: state}
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		outer := response.State.Schema
+		state, err := withRegion(ctx, innerResponse.State.Raw, outer, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -57,76 +240,144 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
 	if v, ok := w.inner.(datasource.DataSourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
 		return v.ConfigValidators(ctx)
 	}
 
 	return nil
 }
 
+func (w *wrappedDataSource) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
+// overrideRegion returns any per-resource Region override value from the specified raw value.
+func (w *wrappedDataSource) overrideRegion(raw tftypes.Value) string {
+	if !w.isRegionOverrideEnabled() {
+		return ""
+	}
+
+	return regionString(raw)
+}
+
+// schema returns the inner data source's schema.
+func (w *wrappedDataSource) schema(ctx context.Context) datasourceschema.Schema {
+	if w.innerSchema == nil {
+		var response datasource.SchemaResponse
+		w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+		w.innerSchema = &response.Schema
+	}
+
+	return *w.innerSchema
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
 type wrappedEphemeralResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            ephemeral.EphemeralResourceWithConfigure
+	innerSchema      *ephemeralschema.Schema
 	meta             *conns.AWSClient
 	interceptors     ephemeralResourceInterceptors
+	region           *types.ServicePackageResourceRegion
 }
 
-func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure, interceptors ephemeralResourceInterceptors) ephemeral.EphemeralResourceWithConfigure {
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure, interceptors ephemeralResourceInterceptors, region *types.ServicePackageResourceRegion) ephemeral.EphemeralResourceWithConfigure {
 	return &wrappedEphemeralResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
 func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled() {
+		attributes := maps.Clone(response.Schema.Attributes)
+		attributes[names.AttrRegion] = ephemeralResourceRegionSchemaAttribute()
+		response.Schema.Attributes = attributes
+	}
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Open(ctx, request, response)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
+
+	if !w.isRegionOverrideEnabled() {
+		w.inner.Open(ctx, request, response)
+		return
+	}
+
+	if w.region.IsValidateOverrideInPartition {
+		if err := w.meta.ValidateInContextRegionInPartition(ctx); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			return
+		}
+	}
+
+	// The inner ephemeral resource is unaware of the `region` attribute.
+	schema := w.schema(ctx)
+	config, errConfig := withoutRegion(ctx, request.Config.Raw, schema)
+	result, errResult := withoutRegion(ctx, response.Result.Raw, schema)
+	if err := errors.Join(errConfig, errResult); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+		return
+	}
+
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+	innerResponse.Result = tfsdk.EphemeralResultData{Schema: schema, Raw: result}
+
+	w.inner.Open(ctx, innerRequest, &innerResponse)
+
+	outer := response.Result.Schema
+	result, err := withRegion(ctx, innerResponse.Result.Raw, outer, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+	*response = innerResponse
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+		return
+	}
+	response.Result = tfsdk.EphemeralResultData{Schema: outer, Raw: result}
 }
 
 func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
 		v.Renew(ctx, request, response)
 	}
 }
 
 func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
 		v.Close(ctx, request, response)
 	}
 }
 
 func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
 		return v.ConfigValidators(ctx)
 	}
 
@@ -135,74 +386,242 @@ func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephem
 
 func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
 	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
+
+		if w.isRegionOverrideEnabled() {
+			schema := w.schema(ctx)
+			config, err := withoutRegion(ctx, request.Config.Raw, schema)
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: config}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
+func (w *wrappedEphemeralResource) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
+// overrideRegion returns any per-resource Region override value from the specified raw value.
+func (w *wrappedEphemeralResource) overrideRegion(raw tftypes.Value) string {
+	if !w.isRegionOverrideEnabled() {
+		return ""
+	}
+
+	return regionString(raw)
+}
+
+// schema returns the inner ephemeral resource's schema.
+func (w *wrappedEphemeralResource) schema(ctx context.Context) ephemeralschema.Schema {
+	if w.innerSchema == nil {
+		var response ephemeral.SchemaResponse
+		w.inner.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+		w.innerSchema = &response.Schema
+	}
+
+	return *w.innerSchema
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	inner            resource.ResourceWithConfigure
+	innerSchema      *resourceschema.Schema
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	region           *types.ServicePackageResourceRegion
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, region *types.ServicePackageResourceRegion) *wrappedResource {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		region:           region,
 	}
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Metadata(ctx, request, response)
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled() {
+		attributes := maps.Clone(response.Schema.Attributes)
+		attributes[names.AttrRegion] = resourceRegionSchemaAttribute()
+		response.Schema.Attributes = attributes
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			w.inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		config, errConfig := withoutRegion(ctx, request.Config.Raw, schema)
+		plan, errPlan := withoutRegion(ctx, request.Plan.Raw, schema)
+		state, errState := withoutRegion(ctx, response.State.Raw, schema)
+		if err := errors.Join(errConfig, errPlan, errState); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+		innerRequest.Plan = tfsdk.Plan{Schema: schema, Raw: plan}
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: state}
+
+		w.inner.Create(ctx, innerRequest, &innerResponse)
+
+		outer := response.State.Schema
+		state, err := withRegion(ctx, innerResponse.State.Raw, outer, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Plan.Raw), w.meta)
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			w.inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		requestState, errRequestState := withoutRegion(ctx, request.State.Raw, schema)
+		responseState, errResponseState := withoutRegion(ctx, response.State.Raw, schema)
+		if err := errors.Join(errRequestState, errResponseState); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.State = tfsdk.State{Schema: schema, Raw: requestState}
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: responseState}
+
+		w.inner.Read(ctx, innerRequest, &innerResponse)
+
+		// Existing resources with no Region in state have the configured Region set.
+		outer := response.State.Schema
+		state, err := withRegion(ctx, innerResponse.State.Raw, outer, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.State.Raw), w.meta)
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			w.inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		config, errConfig := withoutRegion(ctx, request.Config.Raw, schema)
+		plan, errPlan := withoutRegion(ctx, request.Plan.Raw, schema)
+		requestState, errRequestState := withoutRegion(ctx, request.State.Raw, schema)
+		responseState, errResponseState := withoutRegion(ctx, response.State.Raw, schema)
+		if err := errors.Join(errConfig, errPlan, errRequestState, errResponseState); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+		innerRequest.Plan = tfsdk.Plan{Schema: schema, Raw: plan}
+		innerRequest.State = tfsdk.State{Schema: schema, Raw: requestState}
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: responseState}
+
+		// A change in Region forces replacement, so an update in which only `region` changes has nothing for the inner resource to do.
+		if innerRequest.Plan.Raw.Equal(innerRequest.State.Raw) {
+			innerResponse.State.Raw = innerRequest.Plan.Raw
+		} else {
+			w.inner.Update(ctx, innerRequest, &innerResponse)
+		}
+
+		outer := response.State.Schema
+		state, err := withRegion(ctx, innerResponse.State.Raw, outer, tftypes.NewValue(tftypes.String, w.meta.Region(ctx)))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Plan.Raw), w.meta)
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			w.inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		requestState, errRequestState := withoutRegion(ctx, request.State.Raw, schema)
+		responseState, errResponseState := withoutRegion(ctx, response.State.Raw, schema)
+		if err := errors.Join(errRequestState, errResponseState); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.State = tfsdk.State{Schema: schema, Raw: requestState}
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: responseState}
+
+		w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+		outer := response.State.Schema
+		state, err := withRegion(ctx, innerResponse.State.Raw, outer, regionValue(request.State.Raw))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return response.Diagnostics
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(request.State.Raw), w.meta)
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -211,14 +630,50 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = w.bootstrapContext(ctx, "", w.meta)
 	w.inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		if !w.isRegionOverrideEnabled() {
+			ctx = w.bootstrapContext(ctx, "", w.meta)
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// Import IDs of the form `id@region` import a resource from a specific Region.
+		var overrideRegion string
+		region := tftypes.NewValue(tftypes.String, nil)
+		if id, v, ok := conns.ParseImportIDWithRegion(request.ID); ok {
+			request.ID = id
+			overrideRegion = v
+			region = tftypes.NewValue(tftypes.String, v)
+		}
+		ctx = w.bootstrapContext(ctx, overrideRegion, w.meta)
+
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		state, err := withoutRegion(ctx, response.State.Raw, schema)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return
+		}
+
+		innerResponse := *response
+		innerResponse.State = tfsdk.State{Schema: schema, Raw: state}
+
+		v.ImportState(ctx, request, &innerResponse)
+
+		outer := response.State.Schema
+		state, err = withRegion(ctx, innerResponse.State.Raw, outer, region)
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return
+		}
+		response.State = tfsdk.State{Schema: outer, Raw: state}
 
 		return
 	}
@@ -230,15 +685,77 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !w.isRegionOverrideEnabled() {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			ctx = w.bootstrapContext(ctx, "", w.meta)
+			v.ModifyPlan(ctx, request, response)
+		}
+
+		return
+	}
+
+	// Not a destroy plan.
+	if !request.Plan.Raw.IsNull() && w.meta != nil {
+		if v := regionValue(request.Config.Raw); v.IsNull() {
+			// No Region configured, default to the provider-configured Region.
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.DefaultRegion(ctx))...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Existing resources with no Region in state (e.g. managed before per-resource Region override was supported)
+		// are not replaced.
+		if !request.State.Raw.IsNull() {
+			if o, n := regionString(request.State.Raw), regionValue(response.Plan.Raw); o != "" && (!n.IsKnown() || regionString(response.Plan.Raw) != o) {
+				response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+			}
+		}
+	}
+
+	ctx = w.bootstrapContext(ctx, w.overrideRegion(response.Plan.Raw), w.meta)
+
+	if w.meta != nil && w.region.IsValidateOverrideInPartition {
+		if err := w.meta.ValidateInContextRegionInPartition(ctx); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		// The inner resource is unaware of the `region` attribute.
+		schema := w.schema(ctx)
+		config, errConfig := withoutRegion(ctx, request.Config.Raw, schema)
+		requestPlan, errRequestPlan := withoutRegion(ctx, request.Plan.Raw, schema)
+		state, errState := withoutRegion(ctx, request.State.Raw, schema)
+		responsePlan, errResponsePlan := withoutRegion(ctx, response.Plan.Raw, schema)
+		if err := errors.Join(errConfig, errRequestPlan, errState, errResponsePlan); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+			return
+		}
+
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config = tfsdk.Config{Schema: schema, Raw: config}
+		innerRequest.Plan = tfsdk.Plan{Schema: schema, Raw: requestPlan}
+		innerRequest.State = tfsdk.State{Schema: schema, Raw: state}
+		innerResponse.Plan = tfsdk.Plan{Schema: schema, Raw: responsePlan}
+
+		v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+		outer := response.Plan.Schema
+		plan, err := withRegion(ctx, innerResponse.Plan.Raw, outer, regionValue(response.Plan.Raw))
+		*response = innerResponse
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+			return
+		}
+		response.Plan = tfsdk.Plan{Schema: outer, Raw: plan}
 	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
 		return v.ConfigValidators(ctx)
 	}
 
@@ -247,16 +764,65 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = w.bootstrapContext(ctx, w.overrideRegion(request.Config.Raw), w.meta)
+
+		if w.isRegionOverrideEnabled() {
+			schema := w.schema(ctx)
+			config, err := withoutRegion(ctx, request.Config.Raw, schema)
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+				return
+			}
+			request.Config = tfsdk.Config{Schema: schema, Raw: config}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-
-		return v.UpgradeState(ctx)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if !w.isRegionOverrideEnabled() {
+			return upgraders
+		}
+
+		wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+		for version, upgrader := range upgraders {
+			if f := upgrader.StateUpgrader; f != nil {
+				upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					region := rawStateRegion(request.RawState)
+					ctx = w.bootstrapContext(ctx, w.overrideRegion(region), w.meta)
+
+					// The inner resource is unaware of the `region` attribute.
+					schema := w.schema(ctx)
+					state, err := withoutRegion(ctx, response.State.Raw, schema)
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+						return
+					}
+
+					innerResponse := *response
+					innerResponse.State = tfsdk.State{Schema: schema, Raw: state}
+
+					f(ctx, request, &innerResponse)
+
+					outer := response.State.Schema
+					state, err = withRegion(ctx, innerResponse.State.Raw, outer, regionValue(region))
+					*response = innerResponse
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+						return
+					}
+					response.State = tfsdk.State{Schema: outer, Raw: state}
+				}
+			}
+			wrapped[version] = upgrader
+		}
+
+		return wrapped
 	}
 
 	return nil
@@ -264,10 +830,164 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-
-		return v.MoveState(ctx)
+		ctx = w.bootstrapContext(ctx, "", w.meta)
+		movers := v.MoveState(ctx)
+
+		if !w.isRegionOverrideEnabled() {
+			return movers
+		}
+
+		wrapped := make([]resource.StateMover, 0, len(movers))
+		for _, mover := range movers {
+			if f := mover.StateMover; f != nil {
+				mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+					region := rawStateRegion(request.SourceRawState)
+					ctx = w.bootstrapContext(ctx, w.overrideRegion(region), w.meta)
+
+					// The inner resource is unaware of the `region` attribute.
+					schema := w.schema(ctx)
+					state, err := withoutRegion(ctx, response.TargetState.Raw, schema)
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("removing %s attribute", names.AttrRegion), err.Error())
+						return
+					}
+
+					innerResponse := *response
+					innerResponse.TargetState = tfsdk.State{Schema: schema, Raw: state}
+
+					f(ctx, request, &innerResponse)
+
+					outer := response.TargetState.Schema
+					state, err = withRegion(ctx, innerResponse.TargetState.Raw, outer, regionValue(region))
+					*response = innerResponse
+					if err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("setting %s attribute", names.AttrRegion), err.Error())
+						return
+					}
+					response.TargetState = tfsdk.State{Schema: outer, Raw: state}
+				}
+			}
+			wrapped = append(wrapped, mover)
+		}
+
+		return wrapped
 	}
 
 	return nil
 }
+
+func (w *wrappedResource) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
+// overrideRegion returns any per-resource Region override value from the specified raw value.
+func (w *wrappedResource) overrideRegion(raw tftypes.Value) string {
+	if !w.isRegionOverrideEnabled() {
+		return ""
+	}
+
+	return regionString(raw)
+}
+
+// schema returns the inner resource's schema.
+func (w *wrappedResource) schema(ctx context.Context) resourceschema.Schema {
+	if w.innerSchema == nil {
+		var response resource.SchemaResponse
+		w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+		w.innerSchema = &response.Schema
+	}
+
+	return *w.innerSchema
+}
+
+// wrappedListResource represents a dispatcher for a list resource.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	identity         *types.ServicePackageResourceIdentity
+	inner            list.ListResource
+	meta             *conns.AWSClient
+	region           *types.ServicePackageResourceRegion
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResource, identity *types.ServicePackageResourceIdentity, region *types.ServicePackageResourceRegion) list.ListResource {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		identity:         identity,
+		inner:            inner,
+		region:           region,
+	}
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, providerData any) {
+	if v, ok := providerData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, "", w.meta)
+	w.inner.Configure(ctx, providerData)
+}
+
+// List lists AWS objects, completing each result's identity with the AWS account ID and Region.
+// Resource types that support per-resource Region override can be listed in another Region
+// by specifying a `region` filter.
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	var overrideRegion string
+	if v, ok := request.Filters[names.AttrRegion]; ok {
+		if !w.isRegionOverrideEnabled() {
+			return fmt.Errorf("unsupported filter %q", names.AttrRegion)
+		}
+
+		overrideRegion = v
+		request.Filters = maps.Clone(request.Filters)
+		delete(request.Filters, names.AttrRegion)
+	}
+
+	ctx = w.bootstrapContext(ctx, overrideRegion, w.meta)
+
+	if overrideRegion != "" && w.region.IsValidateOverrideInPartition {
+		if err := w.meta.ValidateInContextRegionInPartition(ctx); err != nil {
+			return err
+		}
+	}
+
+	accountID, region := w.meta.AccountID(ctx), w.meta.Region(ctx)
+
+	var errs []error
+	err := w.inner.List(ctx, request, func(result list.ListResult) bool {
+		identity, err := w.identity.Values(accountID, region, result.Identity)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.ImportID, err))
+			return false
+		}
+
+		result.Identity = identity
+		if overrideRegion != "" {
+			result.ImportID = fmt.Sprintf("%s@%s", result.ImportID, overrideRegion)
+		}
+
+		return yield(result)
+	})
+
+	return errors.Join(append(errs, err)...)
+}
+
+func (w *wrappedListResource) isRegionOverrideEnabled() bool {
+	return w.region != nil && w.region.IsOverrideEnabled
+}
+
+// rawStateRegion returns an object value containing any top-level `region` attribute value in the specified raw state.
+func rawStateRegion(rawState *tfprotov6.RawState) tftypes.Value {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{names.AttrRegion: tftypes.String}}
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if rawState != nil && rawState.JSON != nil {
+		var v struct {
+			Region *string `json:"region"`
+		}
+		if err := json.Unmarshal(rawState.JSON, &v); err == nil && v.Region != nil {
+			region = tftypes.NewValue(tftypes.String, *v.Region)
+		}
+	}
+
+	return tftypes.NewValue(typ, map[string]tftypes.Value{names.AttrRegion: region})
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testAction is an action that records the configuration it's invoked with.
+type testAction struct {
+	config tftypes.Value
+}
+
+func (a *testAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_test_action"
+}
+
+func (a *testAction) Schema(_ context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (a *testAction) Configure(context.Context, action.ConfigureRequest, *action.ConfigureResponse) {}
+
+func (a *testAction) Invoke(_ context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	a.config = request.Config.Raw
+}
+
+func TestWrappedActionSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region     *types.ServicePackageResourceRegion
+		wantRegion bool
+	}{
+		"no Region": {},
+		"Region override disabled": {
+			region: &types.ServicePackageResourceRegion{},
+		},
+		"Region override enabled": {
+			region:     &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+			wantRegion: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			w := newWrappedAction(testBootstrapContext, &testAction{}, testCase.region)
+			var response action.SchemaResponse
+			w.Schema(ctx, action.SchemaRequest{}, &response)
+
+			if _, ok := response.Schema.Attributes[names.AttrName]; !ok {
+				t.Errorf("%s attribute not found", names.AttrName)
+			}
+			if _, ok := response.Schema.Attributes[names.AttrRegion]; ok != testCase.wantRegion {
+				t.Errorf("%s attribute found = %t, want %t", names.AttrRegion, ok, testCase.wantRegion)
+			}
+		})
+	}
+}
+
+func TestWrappedActionInvoke(t *testing.T) {
+	t.Parallel()
+
+	innerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrName: tftypes.String,
+	}}
+	outerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrName:   tftypes.String,
+		names.AttrRegion: tftypes.String,
+	}}
+
+	testCases := map[string]struct {
+		region *types.ServicePackageResourceRegion
+		config tftypes.Value
+		want   tftypes.Value
+	}{
+		"Region override disabled": {
+			config: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+			}),
+			want: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"Region override enabled, Region set": {
+			region: &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+			config: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, "us-west-2"),
+			}),
+			want: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"Region override enabled, Region null": {
+			region: &types.ServicePackageResourceRegion{IsOverrideEnabled: true},
+			config: tftypes.NewValue(outerType, map[string]tftypes.Value{
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrRegion: tftypes.NewValue(tftypes.String, nil),
+			}),
+			want: tftypes.NewValue(innerType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			inner := &testAction{}
+			w := newWrappedAction(testBootstrapContext, inner, testCase.region)
+			var schemaResponse action.SchemaResponse
+			w.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+			request := action.InvokeRequest{
+				Config: tfsdk.Config{Schema: schemaResponse.Schema, Raw: testCase.config},
+			}
+			var response action.InvokeResponse
+			w.Invoke(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if diff := cmp.Diff(inner.config, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testBootstrapContext(ctx context.Context, _ string, _ *conns.AWSClient) context.Context {
+	return ctx
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateIdentitySchema verifies that the specified schema defines all of the identity's natural key attributes.
+func validateIdentitySchema(identity *types.ServicePackageResourceIdentity, s map[string]*schema.Schema) error {
+	for _, v := range identity.Attributes {
+		// `id` is implicitly defined for all Plugin SDK resources.
+		if v.Name == names.AttrID {
+			continue
+		}
+
+		if _, ok := s[v.Name]; !ok {
+			return fmt.Errorf("identity attribute `%s` not defined in schema", v.Name)
+		}
+	}
+
+	return nil
+}
+
+// newResourceIdentity returns the Plugin SDK identity schema for the specified resource identity.
+func newResourceIdentity(identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				},
+			}
+			if !identity.IsGlobalResource {
+				s[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+			for _, v := range identity.Attributes {
+				s[v.Name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: v.Required,
+					OptionalForImport: !v.Required,
+				}
+			}
+
+			return s
+		},
+	}
+}
+
+// identityData is implemented by Plugin SDK resource data that supports resource identity.
+type identityData interface {
+	Identity() (*schema.IdentityData, error)
+}
+
+// setIdentityInterceptor sets the resource's identity after CRU.
+func setIdentityInterceptor(identity *types.ServicePackageResourceIdentity) interceptor {
+	return interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+		switch when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+				if d.Id() == "" {
+					return ctx, diags
+				}
+
+				fallthrough
+			case Create, Update:
+				v, ok := d.(identityData)
+				if !ok {
+					return ctx, diags
+				}
+
+				identityData, err := v.Identity()
+				if err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "getting identity: %s", err)
+				}
+
+				if err := setIdentity(ctx, identityData, identity, d, meta.(*conns.AWSClient)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity: %s", err)
+				}
+			}
+		}
+
+		return ctx, diags
+	})
+}
+
+// setIdentity sets the values of all of the identity's attributes from the specified resource data.
+func setIdentity(ctx context.Context, identityData *schema.IdentityData, identity *types.ServicePackageResourceIdentity, d schemaResourceData, c *conns.AWSClient) error {
+	if err := identityData.Set(names.AttrAccountID, c.AccountID(ctx)); err != nil {
+		return err
+	}
+	if !identity.IsGlobalResource {
+		if err := identityData.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+			return err
+		}
+	}
+	for _, v := range identity.Attributes {
+		var value any
+		if v.Name == names.AttrID {
+			value = d.Id()
+		} else {
+			value = d.Get(v.Name)
+		}
+
+		if err := identityData.Set(v.Name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importIdentity returns an importer that accepts an `identity` in place of an import ID.
+// The account ID and Region in the identity must match those of the provider or, for resources that
+// support per-resource Region override, the Region sets the top-level `region` attribute value.
+// For resources identified by a single attribute, the import ID is set from the identity.
+func importIdentity(identity *types.ServicePackageResourceIdentity, isRegionOverrideEnabled bool, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// Importing by ID.
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		identityData, err := d.Identity()
+		if err != nil {
+			return nil, fmt.Errorf("getting identity: %w", err)
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		if v, ok := identityData.GetOk(names.AttrAccountID); ok {
+			if accountID := c.AccountID(ctx); v.(string) != accountID {
+				return nil, fmt.Errorf("identity %s (%s) does not match provider account ID (%s)", names.AttrAccountID, v, accountID)
+			}
+		}
+
+		if !identity.IsGlobalResource {
+			if v, ok := identityData.GetOk(names.AttrRegion); ok {
+				if isRegionOverrideEnabled {
+					if err := d.Set(names.AttrRegion, v); err != nil {
+						return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+					}
+				} else if region := c.Region(ctx); v.(string) != region {
+					return nil, fmt.Errorf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, v, region)
+				}
+			}
+		}
+
+		for _, v := range identity.Attributes {
+			if v.Name == names.AttrID {
+				continue
+			}
+
+			if value, ok := identityData.GetOk(v.Name); ok {
+				if err := d.Set(v.Name, value); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", v.Name, err)
+				}
+			}
+		}
+
+		if len(identity.Attributes) == 1 {
+			name := identity.Attributes[0].Name
+			v, ok := identityData.GetOk(name)
+			if !ok {
+				return nil, fmt.Errorf("identity attribute %s not set", name)
+			}
+
+			d.SetId(v.(string))
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity *types.ServicePackageResourceIdentity
+		want     map[string]bool // Attribute name to RequiredForImport.
+	}{
+		"global": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			want: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrName:      true,
+			},
+		},
+		"regional": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrID),
+			want: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrRegion:    false,
+				names.AttrID:        true,
+			},
+		},
+		"regional optional attribute": {
+			identity: types.RegionalParameterizedIdentity(
+				types.StringIdentityAttribute(names.AttrName, true),
+				types.StringIdentityAttribute("qualifier", false),
+			),
+			want: map[string]bool{
+				names.AttrAccountID: false,
+				names.AttrRegion:    false,
+				names.AttrName:      true,
+				"qualifier":         false,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newResourceIdentity(testCase.identity)
+			s := r.SchemaMap()
+
+			if got, want := len(s), len(testCase.want); got != want {
+				t.Errorf("len(schema) = %d, want %d", got, want)
+			}
+			for k, required := range testCase.want {
+				v, ok := s[k]
+				if !ok {
+					t.Errorf("attribute %s not found", k)
+					continue
+				}
+				if v.Type != schema.TypeString {
+					t.Errorf("attribute %s type = %s, want %s", k, v.Type, schema.TypeString)
+				}
+				if v.RequiredForImport != required || v.OptionalForImport == required {
+					t.Errorf("attribute %s RequiredForImport = %t, OptionalForImport = %t, want RequiredForImport %t", k, v.RequiredForImport, v.OptionalForImport, required)
+				}
+			}
+			if err := r.InternalIdentityValidate(); err != nil {
+				t.Errorf("InternalIdentityValidate: %s", err)
+			}
+		})
+	}
+}
+
+func TestImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := map[string]struct {
+		identity                *types.ServicePackageResourceIdentity
+		isRegionOverrideEnabled bool
+		importID                string
+		identityValues          map[string]string
+		wantErr                 bool
+		wantID                  string
+		wantName                string
+		wantRegion              string
+	}{
+		"import ID": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			importID: "test",
+			wantID:   "test",
+		},
+		"identity": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			identityValues: map[string]string{
+				names.AttrName: "test",
+			},
+			wantID:   "test",
+			wantName: "test",
+		},
+		"identity matching account ID and Region": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			identityValues: map[string]string{
+				names.AttrAccountID: accountID,
+				names.AttrRegion:    region,
+				names.AttrName:      "test",
+			},
+			wantID:   "test",
+			wantName: "test",
+		},
+		"identity id attribute": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrID),
+			identityValues: map[string]string{
+				names.AttrID: "test",
+			},
+			wantID: "test",
+		},
+		"identity wrong account ID": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			identityValues: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "test",
+			},
+			wantErr: true,
+		},
+		"identity wrong Region": {
+			identity: types.RegionalSingleParameterIdentity(names.AttrName),
+			identityValues: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			wantErr: true,
+		},
+		"identity Region override": {
+			identity:                types.RegionalSingleParameterIdentity(names.AttrName),
+			isRegionOverrideEnabled: true,
+			identityValues: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			wantID:     "test",
+			wantName:   "test",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"global identity": {
+			identity: types.GlobalSingleParameterIdentity(names.AttrName),
+			identityValues: map[string]string{
+				names.AttrName: "test",
+			},
+			wantID:   "test",
+			wantName: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			meta := &conns.AWSClient{}
+			conns.SetAccountID(meta, accountID)
+			conns.SetRegion(meta, region)
+
+			resourceSchema := map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRegion: regionSchema(),
+			}
+			identitySchema := newResourceIdentity(testCase.identity).SchemaMap()
+			d := schema.TestResourceDataWithIdentityRaw(t, resourceSchema, identitySchema, testCase.identityValues)
+			d.SetId(testCase.importID)
+
+			f := importIdentity(testCase.identity, testCase.isRegionOverrideEnabled, func(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+				return []*schema.ResourceData{d}, nil
+			})
+			_, err := f(ctx, d, meta)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := d.Id(), testCase.wantID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrName).(string), testCase.wantName; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrName, got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.wantRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
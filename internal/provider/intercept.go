@@ -92,7 +92,7 @@ func (s interceptorItems) why(why why) interceptorItems {
 func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
-		ctx = bootstrapContext(ctx, meta)
+		ctx = bootstrapContext(ctx, d.GetOk, meta)
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -135,7 +135,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 }
 
 // contextFunc augments Context.
-type contextFunc func(context.Context, any) context.Context
+type contextFunc func(context.Context, getAttributeFunc, any) context.Context
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin SDK v2 data source.
 type wrappedDataSource struct {
@@ -173,7 +173,7 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = r.bootstrapContext(ctx, meta)
+		ctx = r.bootstrapContext(ctx, d.GetOk, meta)
 
 		return f(ctx, d, meta)
 	}
@@ -181,7 +181,7 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
-		ctx = r.bootstrapContext(ctx, meta)
+		ctx = r.bootstrapContext(ctx, d.GetOk, meta)
 
 		return f(ctx, d, meta)
 	}
@@ -189,7 +189,7 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
-		ctx = r.bootstrapContext(ctx, meta)
+		ctx = r.bootstrapContext(ctx, rawStateGetAttribute(rawState), meta)
 
 		return f(ctx, rawState, meta)
 	}
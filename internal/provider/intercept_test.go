@@ -84,7 +84,7 @@ func TestInterceptedHandler(t *testing.T) {
 		var diags diag.Diagnostics
 		return sdkdiag.AppendErrorf(diags, "read error")
 	}
-	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+	bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
 		return ctx
 	}
 
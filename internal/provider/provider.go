@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -291,9 +292,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
+				var region string
+				if isRegionOverrideEnabled {
+					region = overrideRegion(getAttribute)
+				}
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, region)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -327,6 +334,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if isRegionOverrideEnabled {
+				// The data source has opted in to per-resource Region override.
+				// Ensure that the schema doesn't already define a `region` attribute.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+				addRegionToSchema(r)
+
+				if v.Region.IsValidateOverrideInPartition {
+					interceptors = append(interceptors, interceptorItem{
+						when:        Before,
+						why:         Read,
+						interceptor: validateRegionInPartitionInterceptor(),
+					})
+				}
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: setRegionInStateInterceptor(),
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -367,9 +397,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+			identity := v.Identity
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+			bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
+				var region string
+				if isRegionOverrideEnabled {
+					region = overrideRegion(getAttribute)
+				}
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, region)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -414,6 +451,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if isRegionOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema doesn't already define a `region` attribute.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute already defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+				addRegionToSchema(r)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: setRegionInStateInterceptor(),
+				})
+
+				// A change in Region forces replacement, but the attribute itself isn't ForceNew
+				// so that existing resources with no Region in state can be updated in-place.
+				if r.UpdateWithoutTimeout == nil {
+					r.UpdateWithoutTimeout = schema.NoopContext
+				}
+				customizeDiffFuncs := []schema.CustomizeDiffFunc{
+					defaultRegion(v.Region.IsValidateOverrideInPartition),
+					forceNewIfRegionChanges,
+				}
+				if v := r.CustomizeDiff; v != nil {
+					customizeDiffFuncs = append(customizeDiffFuncs, v)
+				}
+				r.CustomizeDiff = customdiff.Sequence(customizeDiffFuncs...)
+			}
+
+			if identity != nil {
+				// The resource has declared an identity.
+				// Ensure that the schema defines all of the identity's natural key attributes.
+				if err := validateIdentitySchema(identity, r.SchemaMap()); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+				if r.Identity != nil {
+					errs = append(errs, fmt.Errorf("identity already defined: %s", typeName))
+					continue
+				}
+				r.Identity = newResourceIdentity(identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: setIdentityInterceptor(identity),
+				})
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -433,7 +520,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = rs.State(v)
+					if isRegionOverrideEnabled {
+						r.Importer.StateContext = importRegion(rs.State(v))
+					} else {
+						r.Importer.StateContext = rs.State(v)
+					}
+				}
+				if identity != nil && r.Importer.StateContext != nil {
+					r.Importer.StateContext = importIdentity(identity, isRegionOverrideEnabled, r.Importer.StateContext)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
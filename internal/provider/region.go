@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionAttributeDescription = "Region where this resource will be managed. Defaults to the Region set in the provider configuration."
+)
+
+// getAttributeFunc represents a function that returns the value of the named attribute and whether it is set.
+type getAttributeFunc func(string) (any, bool)
+
+// regionSchema returns the schema for the top-level `region` attribute injected into resources and data sources
+// that support per-resource Region override.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+	}
+}
+
+// addRegionToSchema adds the top-level `region` attribute to the specified resource's schema.
+// The schema map is copied as it may be shared, e.g. a package-level variable.
+func addRegionToSchema(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := maps.Clone(f())
+			s[names.AttrRegion] = regionSchema()
+			return s
+		}
+	} else {
+		r.Schema = maps.Clone(r.Schema)
+		r.Schema[names.AttrRegion] = regionSchema()
+	}
+}
+
+// overrideRegion returns any per-resource Region override value.
+func overrideRegion(getAttribute getAttributeFunc) string {
+	if getAttribute == nil {
+		return ""
+	}
+
+	if v, ok := getAttribute(names.AttrRegion); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// rawStateGetAttribute returns a getAttributeFunc for the specified raw state.
+func rawStateGetAttribute(rawState map[string]any) getAttributeFunc {
+	return func(key string) (any, bool) {
+		v, ok := rawState[key]
+		return v, ok
+	}
+}
+
+// validateRegionInPartitionInterceptor verifies that any per-resource Region override is in the configured partition.
+func validateRegionInPartitionInterceptor() interceptor {
+	return interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+		switch when {
+		case Before:
+			if err := meta.(*conns.AWSClient).ValidateInContextRegionInPartition(ctx); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		return ctx, diags
+	})
+}
+
+// setRegionInStateInterceptor sets the value of the top-level `region` attribute in state after CRU.
+func setRegionInStateInterceptor() interceptor {
+	return interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+		switch when {
+		case After:
+			switch why {
+			case Read:
+				// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+				if d.Id() == "" {
+					return ctx, diags
+				}
+
+				fallthrough
+			case Create, Update:
+				if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+				}
+			}
+		}
+
+		return ctx, diags
+	})
+}
+
+// defaultRegion sets the planned value of the top-level `region` attribute to the configured Region if no value is configured.
+func defaultRegion(validateInPartition bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c := meta.(*conns.AWSClient)
+
+		if v := d.GetRawConfig().GetAttr(names.AttrRegion); !v.IsKnown() {
+			return nil
+		} else if !v.IsNull() {
+			// The configured value has already been placed in Context.
+			if validateInPartition {
+				return c.ValidateInContextRegionInPartition(ctx)
+			}
+
+			return nil
+		}
+
+		if region := c.DefaultRegion(ctx); d.Get(names.AttrRegion).(string) != region {
+			return d.SetNew(names.AttrRegion, region)
+		}
+
+		return nil
+	}
+}
+
+// forceNewIfRegionChanges forces resource replacement if the value of the top-level `region` attribute changes.
+// Existing resources with no `region` value in state (e.g. managed before per-resource Region override was supported)
+// are not replaced.
+func forceNewIfRegionChanges(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.HasChange(names.AttrRegion) {
+		return nil
+	}
+
+	if o, _ := d.GetChange(names.AttrRegion); o.(string) == "" {
+		return nil
+	}
+
+	return d.ForceNew(names.AttrRegion)
+}
+
+// importRegion returns an importer that accepts an `id@region` import ID, setting the top-level `region` attribute value.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := conns.ParseImportIDWithRegion(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
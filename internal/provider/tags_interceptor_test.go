@@ -88,8 +88,8 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		"tag2": "tag",
 	}))
 
-	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+	bootstrapContext := func(ctx context.Context, getAttribute getAttributeFunc, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", overrideRegion(getAttribute))
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -97,7 +97,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		return ctx
 	}
 
-	ctx = bootstrapContext(ctx, conn)
+	ctx = bootstrapContext(ctx, nil, conn)
 	d := &resourceData{}
 
 	for _, v := range interceptors {
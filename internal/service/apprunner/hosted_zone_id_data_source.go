@@ -32,6 +32,7 @@ var hostedZoneIDPerRegionMap = map[string]string{
 }
 
 // @FrameworkDataSource("aws_apprunner_hosted_zone_id", name="Hosted Zone ID")
+// @Region(overrideEnabled=false)
 func newHostedZoneIDDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	return &hostedZoneIDDataSource{}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -20,11 +21,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceControl,
 			TypeName: "aws_auditmanager_control",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceFramework,
 			TypeName: "aws_auditmanager_framework",
 			Name:     "Framework",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,6 +38,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAccountRegistration,
 			TypeName: "aws_auditmanager_account_registration",
 			Name:     "Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessment,
@@ -43,16 +47,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessmentDelegation,
 			TypeName: "aws_auditmanager_assessment_delegation",
 			Name:     "Assessment Delegation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessmentReport,
 			TypeName: "aws_auditmanager_assessment_report",
 			Name:     "Assessment Report",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceControl,
@@ -61,6 +68,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFramework,
@@ -69,16 +77,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFrameworkShare,
 			TypeName: "aws_auditmanager_framework_share",
 			Name:     "Framework Share",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceOrganizationAdminAccountRegistration,
 			TypeName: "aws_auditmanager_organization_admin_account_registration",
 			Name:     "Organization Admin Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -101,6 +112,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*auditmanager.Options){
 		auditmanager.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *auditmanager.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "auditmanager",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
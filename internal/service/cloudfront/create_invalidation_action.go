@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @Action("aws_cloudfront_create_invalidation", name="Create Invalidation")
+func newCreateInvalidationAction(context.Context) (action.ActionWithConfigure, error) {
+	return &createInvalidationAction{}, nil
+}
+
+const (
+	invalidationCompletedTimeout = 20 * time.Minute
+)
+
+type createInvalidationAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *createInvalidationAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_create_invalidation"
+}
+
+func (a *createInvalidationAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Optional: true,
+			},
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+			},
+			"paths": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Required:   true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Invoke creates a CloudFront invalidation and waits for it to complete.
+func (a *createInvalidationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data createInvalidationActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := fwflex.StringValueFromFramework(ctx, data.DistributionID)
+	callerReference := fwflex.StringValueFromFramework(ctx, data.CallerReference)
+	if callerReference == "" {
+		callerReference = id.UniqueId()
+	}
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, data.Paths)
+	input := cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	output, err := conn.CreateInvalidation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Invalidation (%s)", distributionID), err.Error())
+		return
+	}
+
+	invalidationID := aws.ToString(output.Invalidation.Id)
+	actionwait.SendProgress(response, fmt.Sprintf("Created CloudFront Invalidation (%s) for Distribution (%s)", invalidationID, distributionID))
+
+	if _, err := waitInvalidationCompleted(ctx, conn, distributionID, invalidationID, invalidationCompletedTimeout, response); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Invalidation (%s) complete", invalidationID), err.Error())
+		return
+	}
+}
+
+type createInvalidationActionModel struct {
+	CallerReference types.String         `tfsdk:"caller_reference"`
+	DistributionID  types.String         `tfsdk:"distribution_id"`
+	Paths           fwtypes.ListOfString `tfsdk:"paths"`
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) (*awstypes.Invalidation, error) {
+	input := &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(invalidationID),
+	}
+
+	output, err := conn.GetInvalidation(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchInvalidation](err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+// waitInvalidationCompleted waits for an invalidation to complete, sending a progress message to Terraform on each status change.
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string, timeout time.Duration, response *action.InvokeResponse) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    actionwait.StateRefreshFunc(response, fmt.Sprintf("CloudFront Invalidation (%s)", invalidationID), statusInvalidation(ctx, conn, distributionID, invalidationID)),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
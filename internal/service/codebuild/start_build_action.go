@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_codebuild_start_build", name="Start Build")
+func newStartBuildAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startBuildAction{}, nil
+}
+
+const (
+	buildCompletedTimeout = 60 * time.Minute
+)
+
+type startBuildAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *startBuildAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_codebuild_start_build"
+}
+
+func (a *startBuildAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Required: true,
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTimeout: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "How long to wait for the build to complete. Defaults to 60m.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Invoke starts a CodeBuild build, optionally waiting for it to complete successfully.
+func (a *startBuildAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startBuildActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodeBuildClient(ctx)
+
+	projectName := fwflex.StringValueFromFramework(ctx, data.ProjectName)
+	input := codebuild.StartBuildInput{
+		ProjectName:   aws.String(projectName),
+		SourceVersion: fwflex.StringFromFramework(ctx, data.SourceVersion),
+	}
+
+	output, err := conn.StartBuild(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting CodeBuild Build (%s)", projectName), err.Error())
+		return
+	}
+
+	buildID := aws.ToString(output.Build.Id)
+	actionwait.SendProgress(response, fmt.Sprintf("Started CodeBuild Build (%s)", buildID))
+
+	if !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := buildCompletedTimeout
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueDuration()
+	}
+
+	if _, err := waitBuildCompleted(ctx, conn, buildID, timeout, response); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeBuild Build (%s) complete", buildID), err.Error())
+		return
+	}
+}
+
+type startBuildActionModel struct {
+	ProjectName       types.String     `tfsdk:"project_name"`
+	SourceVersion     types.String     `tfsdk:"source_version"`
+	Timeout           fwtypes.Duration `tfsdk:"timeout"`
+	WaitForCompletion types.Bool       `tfsdk:"wait_for_completion"`
+}
+
+func findBuildByID(ctx context.Context, conn *codebuild.Client, id string) (*awstypes.Build, error) {
+	input := &codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetBuilds(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Builds)
+}
+
+func statusBuild(ctx context.Context, conn *codebuild.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBuildByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+// waitBuildCompleted waits for a build to succeed, sending a progress message to Terraform on each status change.
+func waitBuildCompleted(ctx context.Context, conn *codebuild.Client, id string, timeout time.Duration, response *action.InvokeResponse) (*awstypes.Build, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusTypeInProgress),
+		Target:     enum.Slice(awstypes.StatusTypeSucceeded),
+		Refresh:    actionwait.StateRefreshFunc(response, fmt.Sprintf("CodeBuild Build (%s)", id), statusBuild(ctx, conn, id)),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Build); ok {
+		if phase := aws.ToString(output.CurrentPhase); output.BuildStatus != awstypes.StatusTypeSucceeded && phase != "" {
+			tfresource.SetLastError(err, fmt.Errorf("current phase: %s", phase))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
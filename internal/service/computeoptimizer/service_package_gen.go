@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -24,11 +25,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newEnrollmentStatusResource,
 			TypeName: "aws_computeoptimizer_enrollment_status",
 			Name:     "Enrollment Status",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newRecommendationPreferencesResource,
 			TypeName: "aws_computeoptimizer_recommendation_preferences",
 			Name:     "Recommendation Preferences",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -51,6 +54,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*computeoptimizer.Options){
 		computeoptimizer.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *computeoptimizer.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "computeoptimizer",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -60,6 +60,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*costandusagereportservice.Options){
 		costandusagereportservice.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *costandusagereportservice.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "costandusagereportservice",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		func(o *costandusagereportservice.Options) {
 			switch partition := config["partition"].(string); partition {
 			case endpoints.AwsPartitionID:
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -20,6 +21,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceEnvironmentBlueprint,
 			TypeName: "aws_datazone_environment_blueprint",
 			Name:     "Environment Blueprint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,6 +32,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAssetType,
 			TypeName: "aws_datazone_asset_type",
 			Name:     "Asset Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceDomain,
@@ -38,46 +41,55 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceEnvironment,
 			TypeName: "aws_datazone_environment",
 			Name:     "Environment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceEnvironmentBlueprintConfiguration,
 			TypeName: "aws_datazone_environment_blueprint_configuration",
 			Name:     "Environment Blueprint Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceEnvironmentProfile,
 			TypeName: "aws_datazone_environment_profile",
 			Name:     "Environment Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFormType,
 			TypeName: "aws_datazone_form_type",
 			Name:     "Form Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceGlossary,
 			TypeName: "aws_datazone_glossary",
 			Name:     "Glossary",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceGlossaryTerm,
 			TypeName: "aws_datazone_glossary_term",
 			Name:     "Glossary Term",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceProject,
 			TypeName: "aws_datazone_project",
 			Name:     "Project",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceUserProfile,
 			TypeName: "aws_datazone_user_profile",
 			Name:     "User Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -100,6 +112,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*datazone.Options){
 		datazone.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *datazone.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "datazone",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
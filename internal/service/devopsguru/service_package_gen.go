@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -20,11 +21,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceNotificationChannel,
 			TypeName: "aws_devopsguru_notification_channel",
 			Name:     "Notification Channel",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceResourceCollection,
 			TypeName: "aws_devopsguru_resource_collection",
 			Name:     "Resource Collection",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,21 +38,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceEventSourcesConfig,
 			TypeName: "aws_devopsguru_event_sources_config",
 			Name:     "Event Sources Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceNotificationChannel,
 			TypeName: "aws_devopsguru_notification_channel",
 			Name:     "Notification Channel",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceResourceCollection,
 			TypeName: "aws_devopsguru_resource_collection",
 			Name:     "Resource Collection",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceServiceIntegration,
 			TypeName: "aws_devopsguru_service_integration",
 			Name:     "Service Integration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -72,6 +79,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*devopsguru.Options){
 		devopsguru.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *devopsguru.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "devopsguru",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_instance", name="Instance")
+func newInstanceListResource(context.Context) (list.ListResource, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists EC2 instances that have not been terminated.
+// Filter names and values are those supported by the EC2 DescribeInstances API, e.g. "tag:Name" or "instance-type".
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeInstancesInput{
+		Filters: newAttributeFilterList(request.Filters),
+	}
+	instances, err := findInstances(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range instances {
+		if v.State != nil && v.State.Name == awstypes.InstanceStateNameTerminated {
+			continue
+		}
+
+		id := aws.ToString(v.InstanceId)
+		result := list.ListResult{
+			DisplayName: aws.ToString(keyValueTags(ctx, v.Tags).KeyValue("Name")),
+			Identity:    map[string]string{names.AttrID: id},
+			ImportID:    id,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_ec2_stop_instance", name="Stop Instance")
+func newStopInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	return &stopInstanceAction{}, nil
+}
+
+type stopInstanceAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *stopInstanceAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_ec2_stop_instance"
+}
+
+func (a *stopInstanceAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"force": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrInstanceID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTimeout: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "How long to wait for the instance to stop. Defaults to 10m.",
+			},
+		},
+	}
+}
+
+// Invoke stops an EC2 instance and waits for it to reach the stopped state.
+func (a *stopInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data stopInstanceActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	id := data.InstanceID.ValueString()
+	timeout := instanceStopTimeout
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueDuration()
+	}
+
+	actionwait.SendProgress(response, fmt.Sprintf("Stopping EC2 Instance (%s)", id))
+
+	_, err := conn.StopInstances(ctx, &ec2.StopInstancesInput{
+		Force:       aws.Bool(data.Force.ValueBool()),
+		InstanceIds: []string{id},
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping EC2 Instance (%s)", id), err.Error())
+		return
+	}
+
+	if _, err := waitInstanceStoppedWithProgress(ctx, conn, id, timeout, response); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance (%s) stop", id), err.Error())
+		return
+	}
+}
+
+// waitInstanceStoppedWithProgress is waitInstanceStopped, sending a progress message to Terraform on each instance state change.
+func waitInstanceStoppedWithProgress(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration, response *action.InvokeResponse) (*awstypes.Instance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InstanceStateNamePending,
+			awstypes.InstanceStateNameRunning,
+			awstypes.InstanceStateNameShuttingDown,
+			awstypes.InstanceStateNameStopping,
+		),
+		Target:     enum.Slice(awstypes.InstanceStateNameStopped),
+		Refresh:    actionwait.StateRefreshFunc(response, fmt.Sprintf("EC2 Instance (%s)", id), statusInstance(ctx, conn, id)),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Instance); ok {
+		if stateReason := output.StateReason; stateReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type stopInstanceActionModel struct {
+	Force      types.Bool       `tfsdk:"force"`
+	InstanceID types.String     `tfsdk:"instance_id"`
+	Timeout    fwtypes.Duration `tfsdk:"timeout"`
+}
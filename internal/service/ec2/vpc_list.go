@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_vpc", name="VPC")
+func newVPCListResource(context.Context) (list.ListResource, error) {
+	return &vpcListResource{}, nil
+}
+
+type vpcListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists VPCs.
+// Filter names and values are those supported by the EC2 DescribeVpcs API, e.g. "tag:Name" or "is-default".
+func (l *vpcListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeVpcsInput{
+		Filters: newAttributeFilterList(request.Filters),
+	}
+	vpcs, err := findVPCs(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range vpcs {
+		id := aws.ToString(v.VpcId)
+		result := list.ListResult{
+			DisplayName: aws.ToString(keyValueTags(ctx, v.Tags).KeyValue("Name")),
+			Identity:    map[string]string{names.AttrID: id},
+			ImportID:    id,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
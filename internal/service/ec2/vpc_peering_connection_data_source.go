@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_vpc_peering_connection", name="VPC Peering Connection")
+// @Region(overrideEnabled=false)
 func dataSourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceVPCPeeringConnectionRead,
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource(context.Context) (list.ListResource, error) {
+	return &securityGroupListResource{}, nil
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists security groups.
+// Filter names and values are those supported by the EC2 DescribeSecurityGroups API, e.g. "group-name" or "vpc-id".
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSecurityGroupsInput{
+		Filters: newAttributeFilterList(request.Filters),
+	}
+	groups, err := findSecurityGroups(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range groups {
+		id := aws.ToString(v.GroupId)
+		displayName := aws.ToString(keyValueTags(ctx, v.Tags).KeyValue("Name"))
+		if displayName == "" {
+			displayName = aws.ToString(v.GroupName)
+		}
+		result := list.ListResult{
+			DisplayName: displayName,
+			Identity:    map[string]string{names.AttrID: id},
+			ImportID:    id,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_subnet", name="Subnet")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.Subnet")
 // @Testing(generator=false)
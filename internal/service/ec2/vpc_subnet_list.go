@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_subnet", name="Subnet")
+func newSubnetListResource(context.Context) (list.ListResource, error) {
+	return &subnetListResource{}, nil
+}
+
+type subnetListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists subnets.
+// Filter names and values are those supported by the EC2 DescribeSubnets API, e.g. "tag:Name" or "vpc-id".
+func (l *subnetListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSubnetsInput{
+		Filters: newAttributeFilterList(request.Filters),
+	}
+	subnets, err := findSubnets(ctx, conn, &input)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range subnets {
+		id := aws.ToString(v.SubnetId)
+		result := list.ListResult{
+			DisplayName: aws.ToString(keyValueTags(ctx, v.Tags).KeyValue("Name")),
+			Identity:    map[string]string{names.AttrID: id},
+			ImportID:    id,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -46,6 +46,7 @@ var hostedZoneIDs = map[string]string{
 }
 
 // @SDKDataSource("aws_elastic_beanstalk_hosted_zone", name="Hosted Zone")
+// @Region(overrideEnabled=false)
 func dataSourceHostedZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneRead,
@@ -55,6 +55,7 @@ var hostedZoneIDPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_elb_hosted_zone_id", name="Hosted Zone ID")
+// @Region(overrideEnabled=false)
 func dataSourceHostedZoneID() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneIDRead,
@@ -100,6 +100,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*globalaccelerator.Options){
 		globalaccelerator.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *globalaccelerator.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "globalaccelerator",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		func(o *globalaccelerator.Options) {
 			switch partition := config["partition"].(string); partition {
 			case endpoints.AwsPartitionID:
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func newRoleListResource(context.Context) (list.ListResource, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists IAM roles.
+// Supported filters are "name_prefix" and "path_prefix".
+// Service-linked roles are not listed.
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	if err := request.ValidateFilters(names.AttrNamePrefix, "path_prefix"); err != nil {
+		return err
+	}
+
+	conn := l.Meta().IAMClient(ctx)
+
+	input := iam.ListRolesInput{}
+	if v := request.Filters["path_prefix"]; v != "" {
+		input.PathPrefix = aws.String(v)
+	}
+	namePrefix := request.Filters[names.AttrNamePrefix]
+
+	roles, err := findRoles(ctx, conn, &input, func(v awstypes.Role) bool {
+		return strings.HasPrefix(aws.ToString(v.RoleName), namePrefix) && !strings.HasPrefix(aws.ToString(v.Path), "/aws-service-role/")
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range roles {
+		name := aws.ToString(v.RoleName)
+		result := list.ListResult{
+			DisplayName: name,
+			Identity:    map[string]string{names.AttrName: name},
+			ImportID:    name,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
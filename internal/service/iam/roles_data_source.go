@@ -5,7 +5,6 @@ package iam
 
 import (
 	"context"
-	"reflect"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -16,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -58,26 +58,18 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		input.PathPrefix = aws.String(v.(string))
 	}
 
-	var results []awstypes.Role
-
-	pages := iam.NewListRolesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading IAM roles: %s", err)
+	filter := tfslices.PredicateTrue[awstypes.Role]()
+	if v, ok := d.GetOk("name_regex"); ok {
+		re := regexache.MustCompile(v.(string))
+		filter = func(v awstypes.Role) bool {
+			return re.MatchString(aws.ToString(v.RoleName))
 		}
+	}
 
-		for _, role := range page.Roles {
-			if reflect.ValueOf(role).IsZero() {
-				continue
-			}
-
-			if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(aws.ToString(role.RoleName)) {
-				continue
-			}
+	results, err := findRoles(ctx, conn, input, filter)
 
-			results = append(results, role)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM roles: %s", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
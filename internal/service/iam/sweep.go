@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
@@ -428,29 +429,29 @@ func sweepRoles(region string) error {
 	}
 	conn := client.IAMClient(ctx)
 
-	roles := make([]string, 0)
-	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-			return nil
+	input := iam.ListRolesInput{}
+	output, err := findRoles(ctx, conn, &input, func(v awstypes.Role) bool {
+		roleName := aws.ToString(v.RoleName)
+		if !roleNameFilter(roleName) {
+			log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+			return false
 		}
+		return true
+	})
 
-		if err != nil {
-			return fmt.Errorf("retrieving IAM Roles: %w", err)
-		}
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
+		return nil
+	}
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("retrieving IAM Roles: %w", err)
 	}
 
+	roles := tfslices.ApplyToAll(output, func(v awstypes.Role) string {
+		return aws.ToString(v.RoleName)
+	})
+
 	if len(roles) == 0 {
 		log.Print("[DEBUG] No IAM Roles to sweep")
 		return nil
@@ -551,30 +552,30 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 	// TestAccIAMServiceLinkedRole_basic
 	// TestAccIAMServiceLinkedRole_CustomSuffix_diffSuppressFunc
 	customSuffixRegex := regexache.MustCompile(`_?(tf-acc-test-\d+|ServiceRoleForApplicationAutoScaling_CustomResource)$`)
-	pages := iam.NewListRolesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return sweepResources, err
+	roles, err := findRoles(ctx, conn, input, func(v awstypes.Role) bool {
+		roleName := aws.ToString(v.RoleName)
+
+		if !customSuffixRegex.MatchString(roleName) {
+			tflog.Warn(ctx, "Skipping resource", map[string]any{
+				"skip_reason": "no match",
+				"role_name":   roleName,
+			})
+			return false
 		}
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
+		return true
+	})
 
-			if !customSuffixRegex.MatchString(roleName) {
-				tflog.Warn(ctx, "Skipping resource", map[string]any{
-					"skip_reason": "no match",
-					"role_name":   roleName,
-				})
-				continue
-			}
+	if err != nil {
+		return sweepResources, err
+	}
 
-			r := resourceServiceLinkedRole()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(role.Arn))
+	for _, role := range roles {
+		r := resourceServiceLinkedRole()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(role.Arn))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 	}
 
 	return sweepResources, nil
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_lambda_function", name="Function")
+func newFunctionListResource(context.Context) (list.ListResource, error) {
+	return &functionListResource{}, nil
+}
+
+type functionListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists Lambda functions.
+// Supported filters are "name_prefix" and "runtime".
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	if err := request.ValidateFilters(names.AttrNamePrefix, "runtime"); err != nil {
+		return err
+	}
+
+	conn := l.Meta().LambdaClient(ctx)
+
+	namePrefix, runtime := request.Filters[names.AttrNamePrefix], request.Filters["runtime"]
+	input := lambda.ListFunctionsInput{}
+	functions, err := findFunctions(ctx, conn, &input, func(v awstypes.FunctionConfiguration) bool {
+		return strings.HasPrefix(aws.ToString(v.FunctionName), namePrefix) && (runtime == "" || string(v.Runtime) == runtime)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range functions {
+		name := aws.ToString(v.FunctionName)
+		result := list.ListResult{
+			DisplayName: name,
+			Identity:    map[string]string{"function_name": name},
+			ImportID:    name,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -8,10 +8,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 // @SDKDataSource("aws_lambda_functions", name="Functions")
@@ -42,18 +44,15 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	var functionNames []string
 
 	input := &lambda.ListFunctionsInput{}
-	pages := lambda.NewListFunctionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	functions, err := findFunctions(ctx, conn, input, tfslices.PredicateTrue[awstypes.FunctionConfiguration]())
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "listing Lambda Functions: %s", err)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Functions: %s", err)
+	}
 
-		for _, v := range page.Functions {
-			functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
-			functionNames = append(functionNames, aws.ToString(v.FunctionName))
-		}
+	for _, v := range functions {
+		functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
+		functionNames = append(functionNames, aws.ToString(v.FunctionName))
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @Action("aws_lambda_invoke", name="Invoke")
+func newInvokeAction(context.Context) (action.ActionWithConfigure, error) {
+	return &invokeAction{}, nil
+}
+
+type invokeAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *invokeAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_lambda_invoke"
+}
+
+func (a *invokeAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_context": schema.StringAttribute{
+				Description: "Client context information, as a JSON object, to pass to the function. The value is base64-encoded before being sent.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.JSON(),
+					stringvalidator.LengthAtMost(clientContextMaxDecodedLength),
+				},
+			},
+			"function_name": schema.StringAttribute{
+				Required: true,
+			},
+			"invocation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InvocationType](),
+				Optional:   true,
+			},
+			"payload": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Invoke invokes a Lambda function.
+// By default the function is invoked synchronously and a function error is reported as an error.
+func (a *invokeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data invokeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+
+	functionName := data.FunctionName.ValueString()
+	input := lambda.InvokeInput{
+		InvocationType: awstypes.InvocationTypeRequestResponse,
+	}
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The Invoke API requires the client context to be base64-encoded.
+	if !data.ClientContext.IsNull() {
+		input.ClientContext = aws.String(encodeClientContext(data.ClientContext.ValueString()))
+	}
+
+	actionwait.SendProgress(response, fmt.Sprintf("Invoking Lambda Function (%s)", functionName))
+
+	output, err := conn.Invoke(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+		return
+	}
+
+	if v := aws.ToString(output.FunctionError); v != "" {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s): %s", functionName, v), string(output.Payload))
+		return
+	}
+
+	actionwait.SendProgress(response, fmt.Sprintf("Invoked Lambda Function (%s), status code %d", functionName, output.StatusCode))
+}
+
+type invokeActionModel struct {
+	ClientContext  types.String                                `tfsdk:"client_context"`
+	FunctionName   types.String                                `tfsdk:"function_name"`
+	InvocationType fwtypes.StringEnum[awstypes.InvocationType] `tfsdk:"invocation_type"`
+	Payload        types.String                                `tfsdk:"payload"`
+	Qualifier      types.String                                `tfsdk:"qualifier"`
+}
+
+// The Invoke API limits the base64-encoded client context to 3583 bytes,
+// which is 2685 bytes of JSON before encoding.
+const clientContextMaxDecodedLength = 2685
+
+// encodeClientContext base64-encodes the specified client context JSON.
+func encodeClientContext(v string) string {
+	return base64.StdEncoding.EncodeToString([]byte(v))
+}
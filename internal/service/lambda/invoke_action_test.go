@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEncodeClientContext(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input string
+	}{
+		"empty object": {
+			input: `{}`,
+		},
+		"custom": {
+			input: `{"custom":{"key1":"value1"}}`,
+		},
+		"client and env": {
+			input: `{"client":{"app_title":"test"},"env":{"locale":"en_US"}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflambda.EncodeClientContext(testCase.input)
+
+			decoded, err := base64.StdEncoding.DecodeString(got)
+			if err != nil {
+				t.Fatalf("decoding %q: %s", got, err)
+			}
+
+			if got, want := string(decoded), testCase.input; got != want {
+				t.Errorf("decoded = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccLambdaInvokeAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvokeActionConfig_basic(rName, `{"key1":"value1"}`, ""),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvokeAction_clientContext(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvokeActionConfig_basic(rName, `{"key1":"value1"}`, `client_context = jsonencode({ custom = { key1 = "value1" } })`),
+			},
+		},
+	})
+}
+
+func testAccInvokeActionConfig_basic(rName, payload, extraArgs string) string {
+	return acctest.ConfigCompose(
+		testAccInvocationConfig_function("lambda_invocation", rName, "value3"),
+		fmt.Sprintf(`
+action "aws_lambda_invoke" "test" {
+  config {
+    function_name = aws_lambda_function.test.function_name
+    payload       = %[1]q
+    %[2]s
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_lambda_function.test.function_name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_invoke.test]
+    }
+  }
+}
+`, payload, extraArgs))
+}
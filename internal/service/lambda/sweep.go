@@ -10,7 +10,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -41,27 +43,24 @@ func sweepFunctions(region string) error {
 	input := &lambda.ListFunctionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := lambda.NewListFunctionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	functions, err := findFunctions(ctx, conn, input, tfslices.PredicateTrue[awstypes.FunctionConfiguration]())
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
-			return nil
-		}
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
+		return nil
+	}
 
-		if err != nil {
-			return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
-		}
+	if err != nil {
+		return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
+	}
 
-		for _, v := range page.Functions {
-			r := resourceFunction()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(v.FunctionName))
-			d.Set("function_name", v.FunctionName)
+	for _, v := range functions {
+		r := resourceFunction()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.FunctionName))
+		d.Set("function_name", v.FunctionName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
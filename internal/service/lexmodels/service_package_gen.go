@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -28,21 +29,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceBot,
 			TypeName: "aws_lex_bot",
 			Name:     "Bot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBotAlias,
 			TypeName: "aws_lex_bot_alias",
 			Name:     "Bot Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceIntent,
 			TypeName: "aws_lex_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSlotType,
 			TypeName: "aws_lex_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -53,21 +58,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBot,
 			TypeName: "aws_lex_bot",
 			Name:     "Bot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBotAlias,
 			TypeName: "aws_lex_bot_alias",
 			Name:     "Bot Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceIntent,
 			TypeName: "aws_lex_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSlotType,
 			TypeName: "aws_lex_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -82,6 +91,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*lexmodelbuildingservice.Options){
 		lexmodelbuildingservice.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *lexmodelbuildingservice.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "lexmodelbuildingservice",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -27,31 +28,37 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Region: types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceBotLocale,
 			TypeName: "aws_lexv2models_bot_locale",
 			Name:     "Bot Locale",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceBotVersion,
 			TypeName: "aws_lexv2models_bot_version",
 			Name:     "Bot Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceIntent,
 			TypeName: "aws_lexv2models_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlot,
 			TypeName: "aws_lexv2models_slot",
 			Name:     "Slot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlotType,
 			TypeName: "aws_lexv2models_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -74,6 +81,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*lexmodelsv2.Options){
 		lexmodelsv2.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *lexmodelsv2.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "lexmodelsv2",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
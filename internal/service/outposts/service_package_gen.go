@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/outposts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -28,41 +29,49 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceOutpostAsset,
 			TypeName: "aws_outposts_asset",
 			Name:     "Asset",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostAssets,
 			TypeName: "aws_outposts_assets",
 			Name:     "Assets",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpost,
 			TypeName: "aws_outposts_outpost",
 			Name:     "Outpost",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostInstanceType,
 			TypeName: "aws_outposts_outpost_instance_type",
 			Name:     "Outpost Instance Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostInstanceTypes,
 			TypeName: "aws_outposts_outpost_instance_types",
 			Name:     "Outpost Instance Types",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutposts,
 			TypeName: "aws_outposts_outposts",
 			Name:     "Outposts",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSite,
 			TypeName: "aws_outposts_site",
 			Name:     "Site",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSites,
 			TypeName: "aws_outposts_sites",
 			Name:     "Sites",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -81,6 +90,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*outposts.Options){
 		outposts.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *outposts.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "outposts",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -63,6 +63,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*route53recoverycontrolconfig.Options){
 		route53recoverycontrolconfig.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *route53recoverycontrolconfig.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "route53recoverycontrolconfig",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		func(o *route53recoverycontrolconfig.Options) {
 			switch partition := config["partition"].(string); partition {
 			case endpoints.AwsPartitionID:
@@ -75,6 +75,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*route53recoveryreadiness.Options){
 		route53recoveryreadiness.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *route53recoveryreadiness.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "route53recoveryreadiness",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		func(o *route53recoveryreadiness.Options) {
 			switch partition := config["partition"].(string); partition {
 			case endpoints.AwsPartitionID:
@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -49,6 +50,8 @@ const (
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
+// @Region(overrideEnabled=false)
+// @IdentityAttribute("bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
@@ -1617,6 +1620,27 @@ func findBucket(ctx context.Context, conn *s3.Client, bucket string, optFns ...f
 	return err
 }
 
+func findBuckets(ctx context.Context, conn *s3.Client, input *s3.ListBucketsInput, filter tfslices.Predicate[types.Bucket]) ([]types.Bucket, error) {
+	var output []types.Bucket
+
+	pages := s3.NewListBucketsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Buckets {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
 func findBucketRegion(ctx context.Context, awsClient *conns.AWSClient, bucket string, optFns ...func(*s3.Options)) (string, error) {
 	optFns = append(slices.Clone(optFns),
 		func(o *s3.Options) {
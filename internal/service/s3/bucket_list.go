@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_s3_bucket", name="Bucket")
+// @Region(overrideEnabled=false)
+func newBucketListResource(context.Context) (list.ListResource, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithConfigure
+}
+
+// List lists the general purpose buckets in the provider-configured Region.
+// The supported filter is "prefix".
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, yield func(list.ListResult) bool) error {
+	if err := request.ValidateFilters(names.AttrPrefix); err != nil {
+		return err
+	}
+
+	conn := l.Meta().S3Client(ctx)
+
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(l.Meta().Region(ctx)),
+	}
+	if v := request.Filters[names.AttrPrefix]; v != "" {
+		input.Prefix = aws.String(v)
+	}
+
+	buckets, err := findBuckets(ctx, conn, &input, tfslices.PredicateTrue[types.Bucket]())
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range buckets {
+		name := aws.ToString(v.Name)
+		result := list.ListResult{
+			DisplayName: name,
+			Identity:    map[string]string{names.AttrBucket: name},
+			ImportID:    name,
+		}
+
+		if !yield(result) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -58,38 +58,34 @@ func sweepGeneralPurposeBucketObjects(ctx context.Context, client *conns.AWSClie
 	input := s3.ListBucketsInput{
 		BucketRegion: aws.String(client.Region(ctx)),
 	}
-	pages := s3.NewListBucketsPaginator(conn, &input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
+	buckets, err := findBuckets(ctx, conn, &input, func(v types.Bucket) bool {
+		return bucketNameFilter(tflog.SetField(ctx, logKeyBucketName, aws.ToString(v.Name)), v)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		for _, bucket := range page.Buckets {
-			bucketName := aws.ToString(bucket.Name)
-			ctx = tflog.SetField(ctx, logKeyBucketName, bucketName)
-			if !bucketNameFilter(ctx, bucket) {
+	for _, bucket := range buckets {
+		bucketName := aws.ToString(bucket.Name)
+		ctx = tflog.SetField(ctx, logKeyBucketName, bucketName)
+
+		var objectLockEnabled bool
+		objLockConfig, err := findObjectLockConfiguration(ctx, conn, bucketName, "")
+		if !tfresource.NotFound(err) {
+			if err != nil {
+				tflog.Warn(ctx, "Reading S3 Bucket Object Lock Configuration", map[string]any{
+					"error": err.Error(),
+				})
 				continue
 			}
-
-			var objectLockEnabled bool
-			objLockConfig, err := findObjectLockConfiguration(ctx, conn, bucketName, "")
-			if !tfresource.NotFound(err) {
-				if err != nil {
-					tflog.Warn(ctx, "Reading S3 Bucket Object Lock Configuration", map[string]any{
-						"error": err.Error(),
-					})
-					continue
-				}
-				objectLockEnabled = objLockConfig.ObjectLockEnabled == types.ObjectLockEnabledEnabled
-			}
-
-			sweepables = append(sweepables, objectSweeper{
-				conn:   conn,
-				bucket: bucketName,
-				locked: objectLockEnabled,
-			})
+			objectLockEnabled = objLockConfig.ObjectLockEnabled == types.ObjectLockEnabledEnabled
 		}
+
+		sweepables = append(sweepables, objectSweeper{
+			conn:   conn,
+			bucket: bucketName,
+			locked: objectLockEnabled,
+		})
 	}
 
 	return sweepables, nil
@@ -169,24 +165,18 @@ func sweepBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 	input := s3.ListBucketsInput{
 		BucketRegion: aws.String(client.Region(ctx)),
 	}
-	pages := s3.NewListBucketsPaginator(conn, &input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, bucket := range page.Buckets {
-			ctx = tflog.SetField(ctx, logKeyBucketName, aws.ToString(bucket.Name))
-			if !bucketNameFilter(ctx, bucket) {
-				continue
-			}
+	buckets, err := findBuckets(ctx, conn, &input, func(v types.Bucket) bool {
+		return bucketNameFilter(tflog.SetField(ctx, logKeyBucketName, aws.ToString(v.Name)), v)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			d := r.Data(nil)
-			d.SetId(aws.ToString(bucket.Name))
+	for _, bucket := range buckets {
+		d := r.Data(nil)
+		d.SetId(aws.ToString(bucket.Name))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
 	}
 
 	return sweepResources, nil
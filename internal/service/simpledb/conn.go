@@ -21,7 +21,7 @@ import (
 )
 
 var (
-	conn *simpledb.SimpleDB
+	connsByRegion = make(map[string]*simpledb.SimpleDB) // Keyed by Region.
 )
 
 // Adapted from
@@ -37,11 +37,14 @@ func simpleDBConn(ctx context.Context, c *conns.AWSClient) *simpledb.SimpleDB {
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	if conn != nil {
+	region := c.Region(ctx)
+	if conn, ok := connsByRegion[region]; ok {
 		return conn
 	}
 
-	cfg := aws.Config{}
+	cfg := aws.Config{
+		Region: aws.String(region),
+	}
 
 	if endpoint := resolveEndpoint(ctx, c); endpoint != "" {
 		tflog.Debug(ctx, "setting endpoint", map[string]any{
@@ -52,7 +55,8 @@ func simpleDBConn(ctx context.Context, c *conns.AWSClient) *simpledb.SimpleDB {
 		cfg.EndpointResolver = newEndpointResolverSDKv1(ctx)
 	}
 
-	conn = simpledb.New(c.AwsSession(ctx).Copy(&cfg))
+	conn := simpledb.New(c.AwsSession(ctx).Copy(&cfg))
+	connsByRegion[region] = conn
 
 	return conn
 }
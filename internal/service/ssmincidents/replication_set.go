@@ -31,6 +31,7 @@ const (
 )
 
 // @SDKResource("aws_ssmincidents_replication_set", name="Replication Set")
+// @Region(overrideEnabled=false)
 // @Tags(identifierAttribute="id")
 func ResourceReplicationSet() *schema.Resource {
 	return &schema.Resource{
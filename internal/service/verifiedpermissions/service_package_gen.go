@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -20,6 +21,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourcePolicyStore,
 			TypeName: "aws_verifiedpermissions_policy_store",
 			Name:     "Policy Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,26 +32,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceIdentitySource,
 			TypeName: "aws_verifiedpermissions_identity_source",
 			Name:     "Identity Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicy,
 			TypeName: "aws_verifiedpermissions_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicyStore,
 			TypeName: "aws_verifiedpermissions_policy_store",
 			Name:     "Policy Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicyTemplate,
 			TypeName: "aws_verifiedpermissions_policy_template",
 			Name:     "Policy Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSchema,
 			TypeName: "aws_verifiedpermissions_schema",
 			Name:     "Schema",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -72,6 +79,16 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 	optFns := []func(*verifiedpermissions.Options){
 		verifiedpermissions.WithEndpointResolverV2(newEndpointResolverV2()),
 		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *verifiedpermissions.Options) {
+			if region := config[names.AttrRegion].(string); o.Region != region {
+				tflog.Info(ctx, "overriding provider-configured AWS API region", map[string]any{
+					"service":         "verifiedpermissions",
+					"original_region": o.Region,
+					"override_region": region,
+				})
+				o.Region = region
+			}
+		},
 		withExtraOptions(ctx, p, config),
 	}
 
@@ -5,11 +5,15 @@ package types
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/list"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -18,12 +22,116 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled             bool // Is per-resource Region override supported?
+	IsValidateOverrideInPartition bool // Is the per-resource Region override value validated against the configured partition?
+}
+
+// ResourceRegionDefault returns the default resource-level Region information.
+// Per-resource Region override is enabled and validated against the configured partition.
+func ResourceRegionDefault() *ServicePackageResourceRegion {
+	return &ServicePackageResourceRegion{
+		IsOverrideEnabled:             true,
+		IsValidateOverrideInPartition: true,
+	}
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity is made up of the AWS account ID, the Region (for regional resources)
+// and one or more natural key attributes, e.g. an S3 bucket's name.
+type ServicePackageResourceIdentity struct {
+	IsGlobalResource bool                                      // Is the resource global, i.e. not scoped to a Region?
+	Attributes       []ServicePackageResourceIdentityAttribute // Natural key attributes.
+}
+
+// ServicePackageResourceIdentityAttribute represents a natural key attribute of a resource's identity.
+type ServicePackageResourceIdentityAttribute struct {
+	Name     string // The name of the attribute in the resource's schema.
+	Required bool   // Is the attribute required to identify the resource?
+}
+
+// Values returns the values of all attributes in the identity of an AWS object in the specified account and Region,
+// given the values of the object's natural key attributes.
+func (i *ServicePackageResourceIdentity) Values(accountID, region string, attributes map[string]string) (map[string]string, error) {
+	values := map[string]string{
+		names.AttrAccountID: accountID,
+	}
+	if !i.IsGlobalResource {
+		values[names.AttrRegion] = region
+	}
+	for _, v := range i.Attributes {
+		value, ok := attributes[v.Name]
+		if !ok || value == "" {
+			if v.Required {
+				return nil, fmt.Errorf("required identity attribute `%s` not set", v.Name)
+			}
+			continue
+		}
+		values[v.Name] = value
+	}
+
+	return values, nil
+}
+
+// StringIdentityAttribute returns a natural key identity attribute.
+func StringIdentityAttribute(name string, required bool) ServicePackageResourceIdentityAttribute {
+	return ServicePackageResourceIdentityAttribute{
+		Name:     name,
+		Required: required,
+	}
+}
+
+// GlobalSingleParameterIdentity returns the identity of a global resource identified by a single required attribute.
+func GlobalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return GlobalParameterizedIdentity(StringIdentityAttribute(name, true))
+}
+
+// GlobalParameterizedIdentity returns the identity of a global resource identified by the specified attributes.
+func GlobalParameterizedIdentity(attributes ...ServicePackageResourceIdentityAttribute) *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IsGlobalResource: true,
+		Attributes:       attributes,
+	}
+}
+
+// RegionalSingleParameterIdentity returns the identity of a regional resource identified by a single required attribute.
+func RegionalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return RegionalParameterizedIdentity(StringIdentityAttribute(name, true))
+}
+
+// RegionalParameterizedIdentity returns the identity of a regional resource identified by the specified attributes.
+func RegionalParameterizedIdentity(attributes ...ServicePackageResourceIdentityAttribute) *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		Attributes: attributes,
+	}
+}
+
+// ServicePackageAction represents a Terraform Plugin Framework action
+// implemented by a service package.
+type ServicePackageAction struct {
+	Factory  func(context.Context) (action.ActionWithConfigure, error)
+	TypeName string
+	Name     string
+	Region   *ServicePackageResourceRegion
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
+}
+
+// ServicePackageListResource represents a list resource implemented by a service package.
+// TypeName is the type name of the managed resource whose AWS objects are listed.
+type ServicePackageListResource struct {
+	Factory  func(context.Context) (list.ListResource, error)
+	TypeName string
+	Name     string
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -33,6 +141,7 @@ type ServicePackageFrameworkDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -42,6 +151,8 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -51,6 +162,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -60,4 +172,6 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 }
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServicePackageResourceIdentityValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity      *ServicePackageResourceIdentity
+		attributes    map[string]string
+		expected      map[string]string
+		expectedError bool
+	}{
+		"global": {
+			identity:   GlobalSingleParameterIdentity("name"),
+			attributes: map[string]string{"name": "example"},
+			expected:   map[string]string{"account_id": "123456789012", "name": "example"},
+		},
+		"regional": {
+			identity:   RegionalSingleParameterIdentity("bucket"),
+			attributes: map[string]string{"bucket": "example"},
+			expected:   map[string]string{"account_id": "123456789012", "region": "us-west-2", "bucket": "example"}, //lintignore:AWSAT003
+		},
+		"optional attribute not set": {
+			identity: RegionalParameterizedIdentity(
+				StringIdentityAttribute("cluster_name", true),
+				StringIdentityAttribute("name", false),
+			),
+			attributes: map[string]string{"cluster_name": "example"},
+			expected:   map[string]string{"account_id": "123456789012", "region": "us-west-2", "cluster_name": "example"}, //lintignore:AWSAT003
+		},
+		"required attribute not set": {
+			identity:      RegionalSingleParameterIdentity("bucket"),
+			attributes:    map[string]string{},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.identity.Values("123456789012", "us-west-2", testCase.attributes) //lintignore:AWSAT003
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("Values() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
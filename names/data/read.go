@@ -238,6 +238,12 @@ func (sr ServiceRecord) EndpointRegionOverrides() map[string]string {
 	return nil
 }
 
+// IsGlobal returns whether the service's resources are global (not Regional).
+// Services with endpoint Region overrides are always global.
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal || len(sr.EndpointRegionOverrides()) > 0
+}
+
 func (sr ServiceRecord) Note() string {
 	return sr.service.Note
 }
@@ -340,6 +346,7 @@ type Service struct {
 	FilePrefix                    string   `hcl:"file_prefix,optional"`
 	DocPrefix                     []string `hcl:"doc_prefix,optional"`
 	Brand                         string   `hcl:"brand,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	Exclude                       bool     `hcl:"exclude,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`